@@ -42,6 +42,7 @@ func (c *demoClient) animeList(ctx context.Context) {
 
 	// Get anime list with options to include specific limit and includes.
 	anime, _, err := c.Anime.List(
+		ctx,
 		kitsu.Limit(results),
 		kitsu.Include("genres"),
 	)
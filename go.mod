@@ -4,7 +4,4 @@ go 1.23.0
 
 toolchain go1.24.1
 
-require (
-	github.com/google/jsonapi v1.0.0
-	golang.org/x/oauth2 v0.27.0
-)
+require golang.org/x/oauth2 v0.27.0
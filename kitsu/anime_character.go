@@ -0,0 +1,72 @@
+package kitsu
+
+import (
+	"context"
+	"fmt"
+	"iter"
+)
+
+// AnimeCharacterService handles communication with the anime character related
+// methods of the Kitsu API.
+//
+// Kitsu API docs:
+// http://docs.kitsu.apiary.io/#reference/characters-&-people/anime-characters
+type AnimeCharacterService service
+
+// AnimeCharacter represents the characters of an Anime entry.
+//
+// Additional filters: animeId
+type AnimeCharacter struct {
+	ID   string `jsonapi:"primary,animeCharacters"`
+	Role string `jsonapi:"attr,role"` // e.g. main or supporting
+
+	Anime     *Anime     `jsonapi:"relation,anime,omitempty"`
+	Character *Character `jsonapi:"relation,character,omitempty"`
+}
+
+// Show returns details for a specific AnimeCharacter by providing a unique
+// identifier of the anime character e.g. 1.
+func (s *AnimeCharacterService) Show(ctx context.Context, animeCharacterID string, opts ...URLOption) (*AnimeCharacter, *Response, error) {
+	u := fmt.Sprintf(defaultAPIVersion+"anime-characters/%s", animeCharacterID)
+
+	req, err := s.client.NewRequest("GET", u, nil, opts...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := new(AnimeCharacter)
+	resp, err := s.client.Do(ctx, req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return v, resp, nil
+}
+
+// List returns a list of AnimeCharacter. Optional parameters can be specified
+// to filter the results and control pagination, sorting etc. The animeId
+// filter returns the characters of a single anime:
+//
+//	client.AnimeCharacter.List(ctx, kitsu.Filter("animeId", "1"), kitsu.Limit(20))
+func (s *AnimeCharacterService) List(ctx context.Context, opts ...URLOption) ([]*AnimeCharacter, *Response, error) {
+	u := defaultAPIVersion + "anime-characters"
+
+	req, err := s.client.NewRequest("GET", u, nil, opts...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var characters []*AnimeCharacter
+	resp, err := s.client.Do(ctx, req, &characters)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return characters, resp, nil
+}
+
+// All returns an iterator over all the AnimeCharacter that match opts; see
+// AnimeService.All.
+func (s *AnimeCharacterService) All(ctx context.Context, opts ...URLOption) iter.Seq2[*AnimeCharacter, error] {
+	return all(ctx, s.List, opts)
+}
@@ -0,0 +1,104 @@
+package kitsu
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestAnimeCharacterService_Show(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/"+defaultAPIVersion+"anime-characters/3", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", defaultMediaType)
+		testFormValues(t, r, values{"include": "character"})
+		fmt.Fprint(w, `{
+			"data":{
+				"id":"3",
+				"type":"animeCharacters",
+				"attributes":{"role":"main"},
+				"relationships":{"character":{"data":{"type":"characters","id":"1"}}}
+			},
+			"included":[{"id":"1","type":"characters","attributes":{"name":"Spike Spiegel"}}]
+		}`)
+	})
+
+	got, _, err := client.AnimeCharacter.Show(context.Background(), "3", Include("character"))
+	if err != nil {
+		t.Fatalf("AnimeCharacter.Show returned error: %v", err)
+	}
+
+	want := &AnimeCharacter{ID: "3", Role: "main", Character: &Character{ID: "1", Name: "Spike Spiegel"}}
+	deepEqual(t, got, want, "AnimeCharacter.Show mismatch")
+}
+
+func TestAnimeCharacterService_List(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/"+defaultAPIVersion+"anime-characters", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", defaultMediaType)
+		testFormValues(t, r, values{
+			"filter[animeId]": "1",
+			"include":         "character",
+			"page[limit]":     "2",
+			"page[offset]":    "2",
+		})
+		fmt.Fprint(w, `{
+			"data":[
+				{
+					"id":"4",
+					"type":"animeCharacters",
+					"attributes":{"role":"main"},
+					"relationships":{"character":{"data":{"type":"characters","id":"2"}}}
+				},
+				{
+					"id":"5",
+					"type":"animeCharacters",
+					"attributes":{"role":"supporting"},
+					"relationships":{"character":{"data":{"type":"characters","id":"3"}}}
+				}
+			],
+			"included":[
+				{"id":"2","type":"characters","attributes":{"name":"Faye Valentine"}},
+				{"id":"3","type":"characters","attributes":{"name":"Ein"}}
+			],
+			"meta":{"count":62},
+			"links":{
+				"first":"https://kitsu.io/api/edge/anime-characters?filter%5BanimeId%5D=1&page%5Blimit%5D=2&page%5Boffset%5D=0",
+				"prev":"https://kitsu.io/api/edge/anime-characters?filter%5BanimeId%5D=1&page%5Blimit%5D=2&page%5Boffset%5D=0",
+				"next":"https://kitsu.io/api/edge/anime-characters?filter%5BanimeId%5D=1&page%5Blimit%5D=2&page%5Boffset%5D=4",
+				"last":"https://kitsu.io/api/edge/anime-characters?filter%5BanimeId%5D=1&page%5Blimit%5D=2&page%5Boffset%5D=60"
+			}
+		}`)
+	})
+
+	got, resp, err := client.AnimeCharacter.List(context.Background(),
+		Filter("animeId", "1"),
+		Include("character"),
+		Pagination(2, 2),
+	)
+	if err != nil {
+		t.Fatalf("AnimeCharacter.List returned error: %v", err)
+	}
+
+	want := []*AnimeCharacter{
+		{ID: "4", Role: "main", Character: &Character{ID: "2", Name: "Faye Valentine"}},
+		{ID: "5", Role: "supporting", Character: &Character{ID: "3", Name: "Ein"}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("AnimeCharacter.List mismatch\nhave: %#+v\nwant: %#+v", got, want)
+	}
+	offset := PageOffset{First: 0, Prev: 0, Next: 4, Last: 60}
+	if got, want := resp.Offset, offset; got != want {
+		t.Errorf("AnimeCharacter.List response Offset = %+v, want %+v", got, want)
+	}
+	if got, want := resp.Meta.Count, 62; got != want {
+		t.Errorf("AnimeCharacter.List response Meta.Count = %d, want %d", got, want)
+	}
+}
@@ -0,0 +1,102 @@
+package kitsu
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"time"
+)
+
+// AnimeProductionService handles communication with the anime production
+// related methods of the Kitsu API.
+//
+// Kitsu API docs:
+// http://docs.kitsu.apiary.io/#reference/characters-&-people/anime-productions
+type AnimeProductionService service
+
+// AnimeProductionRole is the role of a Producer in the production of an
+// Anime.
+type AnimeProductionRole string
+
+// Possible values for AnimeProduction.Role.
+const (
+	AnimeProductionRoleProducer AnimeProductionRole = "producer"
+	AnimeProductionRoleLicensor AnimeProductionRole = "licensor"
+	AnimeProductionRoleStudio   AnimeProductionRole = "studio"
+)
+
+// AnimeProduction represents the involvement of a Producer in the production
+// of an Anime entry, e.g. as its studio.
+//
+// Additional filters: animeId, producerId
+type AnimeProduction struct {
+	ID        string    `jsonapi:"primary,animeProductions"`
+	CreatedAt time.Time `jsonapi:"attr,createdAt,omitempty"` // Date and time of creation, e.g. 2017-07-27T22:21:26.824Z
+	UpdatedAt time.Time `jsonapi:"attr,updatedAt,omitempty"` // Date and time of last modification, e.g. 2017-07-27T22:47:45.129Z
+
+	// Possible values described by the AnimeProductionRole constants.
+	Role AnimeProductionRole `jsonapi:"attr,role,omitempty"`
+
+	Anime    *Anime    `jsonapi:"relation,anime,omitempty"`
+	Producer *Producer `jsonapi:"relation,producer,omitempty"`
+}
+
+// Producer represents a company involved in the production of anime like a
+// studio, a producer or a licensor. Producer is a relationship of
+// AnimeProduction.
+type Producer struct {
+	ID        string    `jsonapi:"primary,producers"`
+	Slug      string    `jsonapi:"attr,slug,omitempty"` // e.g. sunrise
+	Name      string    `jsonapi:"attr,name,omitempty"` // e.g. Sunrise
+	CreatedAt time.Time `jsonapi:"attr,createdAt,omitempty"`
+	UpdatedAt time.Time `jsonapi:"attr,updatedAt,omitempty"`
+
+	AnimeProductions []*AnimeProduction `jsonapi:"relation,animeProductions,omitempty"`
+}
+
+// Show returns details for a specific AnimeProduction by providing a unique
+// identifier of the anime production e.g. 1.
+func (s *AnimeProductionService) Show(ctx context.Context, animeProductionID string, opts ...URLOption) (*AnimeProduction, *Response, error) {
+	u := fmt.Sprintf(defaultAPIVersion+"anime-productions/%s", animeProductionID)
+
+	req, err := s.client.NewRequest("GET", u, nil, opts...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := new(AnimeProduction)
+	resp, err := s.client.Do(ctx, req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return v, resp, nil
+}
+
+// List returns a list of AnimeProduction. Optional parameters can be specified
+// to filter the results and control pagination, sorting etc. The animeId
+// filter returns the productions of a single anime:
+//
+//	client.AnimeProduction.List(ctx, kitsu.Filter("animeId", "1"), kitsu.Limit(20))
+func (s *AnimeProductionService) List(ctx context.Context, opts ...URLOption) ([]*AnimeProduction, *Response, error) {
+	u := defaultAPIVersion + "anime-productions"
+
+	req, err := s.client.NewRequest("GET", u, nil, opts...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var productions []*AnimeProduction
+	resp, err := s.client.Do(ctx, req, &productions)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return productions, resp, nil
+}
+
+// All returns an iterator over all the AnimeProduction that match opts; see
+// AnimeService.All.
+func (s *AnimeProductionService) All(ctx context.Context, opts ...URLOption) iter.Seq2[*AnimeProduction, error] {
+	return all(ctx, s.List, opts)
+}
@@ -0,0 +1,95 @@
+package kitsu
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestAnimeProductionService_Show(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/"+defaultAPIVersion+"anime-productions/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", defaultMediaType)
+		testFormValues(t, r, values{"include": "anime,producer"})
+		fmt.Fprint(w, `{
+			"data":{
+				"id":"2",
+				"type":"animeProductions",
+				"attributes":{"role":"studio"},
+				"relationships":{
+					"anime":{"data":{"type":"anime","id":"1"}},
+					"producer":{"data":{"type":"producers","id":"14"}}
+				}
+			},
+			"included":[
+				{"id":"1","type":"anime","attributes":{"canonicalTitle":"Cowboy Bebop"}},
+				{"id":"14","type":"producers","attributes":{"slug":"sunrise","name":"Sunrise"}}
+			]
+		}`)
+	})
+
+	got, _, err := client.AnimeProduction.Show(context.Background(), "2", Include("anime", "producer"))
+	if err != nil {
+		t.Fatalf("AnimeProduction.Show returned error: %v", err)
+	}
+
+	want := &AnimeProduction{
+		ID:       "2",
+		Role:     AnimeProductionRoleStudio,
+		Anime:    &Anime{ID: "1", Media: Media{CanonicalTitle: "Cowboy Bebop"}},
+		Producer: &Producer{ID: "14", Slug: "sunrise", Name: "Sunrise"},
+	}
+	deepEqual(t, got, want, "AnimeProduction.Show mismatch")
+}
+
+func TestAnimeProductionService_List(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/"+defaultAPIVersion+"anime-productions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", defaultMediaType)
+		testFormValues(t, r, values{
+			"filter[animeId]": "1",
+			"include":         "producer",
+		})
+		fmt.Fprint(w, `{
+			"data":[
+				{
+					"id":"2",
+					"type":"animeProductions",
+					"attributes":{"role":"studio"},
+					"relationships":{"producer":{"data":{"type":"producers","id":"14"}}}
+				},
+				{
+					"id":"3",
+					"type":"animeProductions",
+					"attributes":{"role":"licensor"},
+					"relationships":{"producer":{"data":{"type":"producers","id":"15"}}}
+				}
+			],
+			"included":[
+				{"id":"14","type":"producers","attributes":{"name":"Sunrise"}},
+				{"id":"15","type":"producers","attributes":{"name":"Funimation"}}
+			]
+		}`)
+	})
+
+	got, _, err := client.AnimeProduction.List(context.Background(), Filter("animeId", "1"), Include("producer"))
+	if err != nil {
+		t.Fatalf("AnimeProduction.List returned error: %v", err)
+	}
+
+	want := []*AnimeProduction{
+		{ID: "2", Role: AnimeProductionRoleStudio, Producer: &Producer{ID: "14", Name: "Sunrise"}},
+		{ID: "3", Role: AnimeProductionRoleLicensor, Producer: &Producer{ID: "15", Name: "Funimation"}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("AnimeProduction.List mismatch\nhave: %#+v\nwant: %#+v", got, want)
+	}
+}
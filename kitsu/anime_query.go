@@ -0,0 +1,177 @@
+package kitsu
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+)
+
+// Season is the season of the year in which an Anime started airing.
+type Season string
+
+// The possible seasons to use with AnimeQuery.Season.
+const (
+	SeasonWinter Season = "winter"
+	SeasonSpring Season = "spring"
+	SeasonSummer Season = "summer"
+	SeasonFall   Season = "fall"
+)
+
+// AnimeQuery builds the filters for listing Anime with typed methods instead
+// of free-form Filter options. The methods can be chained and the resulting
+// options can be passed to AnimeService.List or AnimeService.All:
+//
+//	opts, err := kitsu.NewAnimeQuery().
+//		Season(kitsu.SeasonFall).
+//		SeasonYear(2017).
+//		Subtype(kitsu.AnimeSubtypeTV).
+//		AverageRating(80, 100).
+//		Options()
+//	if err != nil {
+//		// Handle invalid query.
+//	}
+//	anime, _, err := c.Anime.List(ctx, opts...)
+//
+// Values are validated as they are added and the first invalid value is
+// reported by Options. Calling a method again replaces the values of its
+// filter.
+type AnimeQuery struct {
+	filters map[string][]string
+	err     error
+}
+
+// NewAnimeQuery returns an empty AnimeQuery.
+func NewAnimeQuery() *AnimeQuery {
+	return &AnimeQuery{filters: make(map[string][]string)}
+}
+
+func (q *AnimeQuery) set(filter string, values ...string) *AnimeQuery {
+	q.filters[filter] = values
+	return q
+}
+
+func (q *AnimeQuery) fail(format string, args ...interface{}) *AnimeQuery {
+	if q.err == nil {
+		q.err = fmt.Errorf("kitsu: invalid anime query: "+format, args...)
+	}
+	return q
+}
+
+// Text filters anime using a full text search on their titles.
+func (q *AnimeQuery) Text(text string) *AnimeQuery {
+	return q.set("text", text)
+}
+
+// Season filters anime that started airing in any of the seasons.
+func (q *AnimeQuery) Season(seasons ...Season) *AnimeQuery {
+	values := make([]string, len(seasons))
+	for i, s := range seasons {
+		switch s {
+		case SeasonWinter, SeasonSpring, SeasonSummer, SeasonFall:
+		default:
+			return q.fail("unknown season %q", s)
+		}
+		values[i] = string(s)
+	}
+	return q.set("season", values...)
+}
+
+// SeasonYear filters anime that started airing in any of the years, e.g.
+// 2017.
+func (q *AnimeQuery) SeasonYear(years ...int) *AnimeQuery {
+	values := make([]string, len(years))
+	for i, y := range years {
+		if y <= 0 {
+			return q.fail("bad season year %d", y)
+		}
+		values[i] = strconv.Itoa(y)
+	}
+	return q.set("seasonYear", values...)
+}
+
+// Subtype filters anime with any of the subtypes.
+func (q *AnimeQuery) Subtype(subtypes ...AnimeSubtype) *AnimeQuery {
+	values := make([]string, len(subtypes))
+	for i, s := range subtypes {
+		switch s {
+		case AnimeSubtypeONA, AnimeSubtypeOVA, AnimeSubtypeTV, AnimeSubtypeMovie, AnimeSubtypeMusic, AnimeSubtypeSpecial:
+		default:
+			return q.fail("unknown subtype %q", s)
+		}
+		values[i] = string(s)
+	}
+	return q.set("subtype", values...)
+}
+
+// Status filters anime with any of the airing statuses.
+func (q *AnimeQuery) Status(statuses ...AnimeStatus) *AnimeQuery {
+	values := make([]string, len(statuses))
+	for i, s := range statuses {
+		switch s {
+		case AnimeStatusCurrent, AnimeStatusFinished, AnimeStatusTBA, AnimeStatusUnreleased, AnimeStatusUpcoming:
+		default:
+			return q.fail("unknown status %q", s)
+		}
+		values[i] = string(s)
+	}
+	return q.set("status", values...)
+}
+
+// AgeRating filters anime with any of the age ratings.
+func (q *AnimeQuery) AgeRating(ratings ...AgeRating) *AnimeQuery {
+	values := make([]string, len(ratings))
+	for i, r := range ratings {
+		switch r {
+		case AgeRatingG, AgeRatingPG, AgeRatingR, AgeRatingR18:
+		default:
+			return q.fail("unknown age rating %q", r)
+		}
+		values[i] = string(r)
+	}
+	return q.set("ageRating", values...)
+}
+
+// Genres filters anime by the slugs of their genres, e.g. action.
+func (q *AnimeQuery) Genres(slugs ...string) *AnimeQuery {
+	return q.set("genres", slugs...)
+}
+
+// Categories filters anime by the slugs of their categories, e.g. space.
+func (q *AnimeQuery) Categories(slugs ...string) *AnimeQuery {
+	return q.set("categories", slugs...)
+}
+
+// Streamers filters anime that are available on any of the streaming
+// services, e.g. Crunchyroll.
+func (q *AnimeQuery) Streamers(names ...string) *AnimeQuery {
+	return q.set("streamers", names...)
+}
+
+// AverageRating filters anime whose average rating is between from and to
+// inclusive. Average ratings are percentages so both must be between 0 and
+// 100.
+func (q *AnimeQuery) AverageRating(from, to float64) *AnimeQuery {
+	if from < 0 || to > 100 || from > to {
+		return q.fail("bad average rating range %v..%v", from, to)
+	}
+	f := func(v float64) string { return strconv.FormatFloat(v, 'f', -1, 64) }
+	return q.set("averageRating", f(from)+".."+f(to))
+}
+
+// Options returns the filters of the query as URL options or the error of the
+// first invalid value that was added to the query.
+func (q *AnimeQuery) Options() ([]URLOption, error) {
+	if q.err != nil {
+		return nil, q.err
+	}
+	names := make([]string, 0, len(q.filters))
+	for name := range q.filters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	opts := make([]URLOption, len(names))
+	for i, name := range names {
+		opts[i] = Filter(name, q.filters[name]...)
+	}
+	return opts, nil
+}
@@ -0,0 +1,88 @@
+package kitsu
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestAnimeQuery(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/"+defaultAPIVersion+"anime", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"filter[text]":          "cowboy",
+			"filter[season]":        "spring,fall",
+			"filter[seasonYear]":    "1998",
+			"filter[subtype]":       "TV,movie",
+			"filter[status]":        "finished",
+			"filter[ageRating]":     "R",
+			"filter[genres]":        "action",
+			"filter[categories]":    "space",
+			"filter[streamers]":     "Crunchyroll",
+			"filter[averageRating]": "80.5..100",
+			"page[limit]":           "5",
+		})
+		fmt.Fprint(w, `{"data":[]}`)
+	})
+
+	opts, err := NewAnimeQuery().
+		Text("cowboy").
+		Season(SeasonSpring, SeasonFall).
+		SeasonYear(1998).
+		Subtype(AnimeSubtypeTV, AnimeSubtypeMovie).
+		Status(AnimeStatusFinished).
+		AgeRating(AgeRatingR).
+		Genres("action").
+		Categories("space").
+		Streamers("Crunchyroll").
+		AverageRating(80.5, 100).
+		Options()
+	if err != nil {
+		t.Fatalf("AnimeQuery.Options returned err: %v", err)
+	}
+	if _, _, err := client.Anime.List(context.Background(), append(opts, Limit(5))...); err != nil {
+		t.Fatalf("Anime.List returned err: %v", err)
+	}
+}
+
+func TestAnimeQuery_replace(t *testing.T) {
+	setup()
+	defer teardown()
+
+	opts, err := NewAnimeQuery().Genres("action").Genres("drama", "comedy").Options()
+	if err != nil {
+		t.Fatalf("AnimeQuery.Options returned err: %v", err)
+	}
+	req, _ := client.NewRequest("GET", "anime", nil, opts...)
+	if got, want := req.URL.Query().Get("filter[genres]"), "drama,comedy"; got != want {
+		t.Errorf("filter[genres] = %q, want %q", got, want)
+	}
+}
+
+func TestAnimeQuery_invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		q    *AnimeQuery
+	}{
+		{"season", NewAnimeQuery().Season("autumn")},
+		{"season year", NewAnimeQuery().SeasonYear(0)},
+		{"subtype", NewAnimeQuery().Subtype("tv")},
+		{"status", NewAnimeQuery().Status("airing")},
+		{"age rating", NewAnimeQuery().AgeRating("PG13")},
+		{"average rating above 100", NewAnimeQuery().AverageRating(90, 101)},
+		{"average rating below 0", NewAnimeQuery().AverageRating(-1, 50)},
+		{"average rating reversed", NewAnimeQuery().AverageRating(90, 80)},
+		{"first error wins", NewAnimeQuery().Subtype("tv").Genres("action")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := tt.q.Options(); err == nil {
+				t.Errorf("AnimeQuery.Options with invalid %s expected to return err", tt.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package kitsu
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"time"
+)
+
+// AnimeStaffService handles communication with the anime staff related methods
+// of the Kitsu API.
+//
+// Kitsu API docs:
+// http://docs.kitsu.apiary.io/#reference/characters-&-people/anime-staff
+type AnimeStaffService service
+
+// AnimeStaff represents the staff of an Anime entry.
+//
+// Additional filters: animeId
+type AnimeStaff struct {
+	ID        string    `jsonapi:"primary,animeStaff"`
+	Role      string    `jsonapi:"attr,role,omitempty"`
+	CreatedAt time.Time `jsonapi:"attr,createdAt,omitempty"` // Date and time of creation, e.g. 2017-07-27T22:21:26.824Z
+	UpdatedAt time.Time `jsonapi:"attr,updatedAt,omitempty"` // Date and time of last modification, e.g. 2017-07-27T22:47:45.129Z
+
+	Anime  *Anime  `jsonapi:"relation,anime,omitempty"`
+	Person *Person `jsonapi:"relation,person,omitempty"`
+}
+
+// Show returns details for a specific AnimeStaff by providing a unique
+// identifier of the anime staff e.g. 1.
+func (s *AnimeStaffService) Show(ctx context.Context, animeStaffID string, opts ...URLOption) (*AnimeStaff, *Response, error) {
+	u := fmt.Sprintf(defaultAPIVersion+"anime-staff/%s", animeStaffID)
+
+	req, err := s.client.NewRequest("GET", u, nil, opts...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := new(AnimeStaff)
+	resp, err := s.client.Do(ctx, req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return v, resp, nil
+}
+
+// List returns a list of AnimeStaff. Optional parameters can be specified
+// to filter the results and control pagination, sorting etc. The animeId
+// filter returns the staff of a single anime:
+//
+//	client.AnimeStaff.List(ctx, kitsu.Filter("animeId", "1"), kitsu.Limit(20))
+func (s *AnimeStaffService) List(ctx context.Context, opts ...URLOption) ([]*AnimeStaff, *Response, error) {
+	u := defaultAPIVersion + "anime-staff"
+
+	req, err := s.client.NewRequest("GET", u, nil, opts...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var staff []*AnimeStaff
+	resp, err := s.client.Do(ctx, req, &staff)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return staff, resp, nil
+}
+
+// All returns an iterator over all the AnimeStaff that match opts; see
+// AnimeService.All.
+func (s *AnimeStaffService) All(ctx context.Context, opts ...URLOption) iter.Seq2[*AnimeStaff, error] {
+	return all(ctx, s.List, opts)
+}
@@ -0,0 +1,80 @@
+package kitsu
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestAnimeStaffService_Show(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/"+defaultAPIVersion+"anime-staff/5", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", defaultMediaType)
+		testFormValues(t, r, values{"include": "person"})
+		fmt.Fprint(w, `{
+			"data":{
+				"id":"5",
+				"type":"animeStaff",
+				"attributes":{"role":"Director"},
+				"relationships":{"person":{"data":{"type":"people","id":"9"}}}
+			},
+			"included":[{"id":"9","type":"people","attributes":{"name":"Shinichirou Watanabe"}}]
+		}`)
+	})
+
+	got, _, err := client.AnimeStaff.Show(context.Background(), "5", Include("person"))
+	if err != nil {
+		t.Fatalf("AnimeStaff.Show returned error: %v", err)
+	}
+
+	want := &AnimeStaff{ID: "5", Role: "Director", Person: &Person{ID: "9", Name: "Shinichirou Watanabe"}}
+	deepEqual(t, got, want, "AnimeStaff.Show mismatch")
+}
+
+func TestAnimeStaffService_List(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/"+defaultAPIVersion+"anime-staff", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", defaultMediaType)
+		testFormValues(t, r, values{
+			"filter[animeId]": "1",
+			"page[limit]":     "2",
+			"page[offset]":    "0",
+		})
+		fmt.Fprint(w, `{
+			"data":[
+				{"id":"5","type":"animeStaff","attributes":{"role":"Director"}},
+				{"id":"6","type":"animeStaff","attributes":{"role":"Music"}}
+			],
+			"links":{
+				"first":"https://kitsu.io/api/edge/anime-staff?filter%5BanimeId%5D=1&page%5Blimit%5D=2&page%5Boffset%5D=0",
+				"next":"https://kitsu.io/api/edge/anime-staff?filter%5BanimeId%5D=1&page%5Blimit%5D=2&page%5Boffset%5D=2",
+				"last":"https://kitsu.io/api/edge/anime-staff?filter%5BanimeId%5D=1&page%5Blimit%5D=2&page%5Boffset%5D=12"
+			}
+		}`)
+	})
+
+	got, resp, err := client.AnimeStaff.List(context.Background(), Filter("animeId", "1"), Pagination(2, 0))
+	if err != nil {
+		t.Fatalf("AnimeStaff.List returned error: %v", err)
+	}
+
+	want := []*AnimeStaff{
+		{ID: "5", Role: "Director"},
+		{ID: "6", Role: "Music"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("AnimeStaff.List mismatch\nhave: %#+v\nwant: %#+v", got, want)
+	}
+	offset := PageOffset{First: 0, Next: 2, Last: 12}
+	if got, want := resp.Offset, offset; got != want {
+		t.Errorf("AnimeStaff.List response Offset = %+v, want %+v", got, want)
+	}
+}
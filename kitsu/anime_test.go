@@ -1,12 +1,14 @@
 package kitsu
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
 	"reflect"
 	"testing"
+	"time"
 )
 
 func TestAnimeService_Show(t *testing.T) {
@@ -24,7 +26,7 @@ func TestAnimeService_Show(t *testing.T) {
 		fmt.Fprintf(w, `{"data":{"id":"7442","type":"anime","attributes":{"slug":"attack-on-titan"}}}`)
 	})
 
-	got, _, err := client.Anime.Show("7442",
+	got, _, err := client.Anime.Show(context.Background(), "7442",
 		Filter("genres", "sports", "sci-fi"),
 		Sort("-followersCount", "-followingCount"),
 		Include("media.genres", "media.installments"),
@@ -33,7 +35,7 @@ func TestAnimeService_Show(t *testing.T) {
 		t.Errorf("Anime.Show returned error: %v", err)
 	}
 
-	want := &Anime{ID: "7442", Slug: "attack-on-titan"}
+	want := &Anime{ID: "7442", Media: Media{Slug: "attack-on-titan"}}
 	if !reflect.DeepEqual(got, want) {
 		t.Errorf("Anime.Show anime mismatch\nhave: %#+v\nwant: %#+v", got, want)
 	}
@@ -93,50 +95,52 @@ func TestAnimeService_Show_decodeAttributes(t *testing.T) {
 		}`)
 	})
 
-	got, _, err := client.Anime.Show("7442")
+	got, _, err := client.Anime.Show(context.Background(), "7442")
 	if err != nil {
 		t.Fatalf("Anime.Show returned error: %v", err)
 	}
 
 	want := &Anime{
-		ID:                  "7442",
-		Slug:                "attack-on-titan",
-		Synopsis:            "Several hundred years ago, humans were nearly exterminated by titans...",
-		CoverImageTopOffset: 263,
-		Titles: map[string]interface{}{
-			"en":    "Attack on Titan",
-			"en_jp": "Shingeki no Kyojin",
-			"ja_jp": "進撃の巨人",
-		},
-		CanonicalTitle:    "Attack on Titan",
-		AbbreviatedTitles: []string{"AoT", "AT"},
-		AverageRating:     "88.65",
-		RatingFrequencies: map[string]interface{}{
-			"0.5": "114",
-			"1.0": "279",
-			"1.5": "146",
-			"2.0": "359",
-			"2.5": "763",
-			"3.0": "2331",
-			"3.5": "3034",
-			"4.0": "5619",
-			"4.5": "5951",
-			"5.0": "12878",
-		},
-		StartDate: "2013-04-07",
-		EndDate:   "2013-09-28",
-		PosterImage: map[string]interface{}{
-			"original": "https://static.hummingbird.me/anime/7442/poster/$1.png",
-		},
-		CoverImage: map[string]interface{}{
-			"original": "https://static.hummingbird.me/anime/7442/cover/$1.png",
+		ID: "7442",
+		Media: Media{
+			Slug:                "attack-on-titan",
+			Synopsis:            "Several hundred years ago, humans were nearly exterminated by titans...",
+			CoverImageTopOffset: 263,
+			Titles: map[string]string{
+				"en":    "Attack on Titan",
+				"en_jp": "Shingeki no Kyojin",
+				"ja_jp": "進撃の巨人",
+			},
+			CanonicalTitle:    "Attack on Titan",
+			AbbreviatedTitles: []string{"AoT", "AT"},
+			AverageRating:     "88.65",
+			RatingFrequencies: map[string]string{
+				"0.5": "114",
+				"1.0": "279",
+				"1.5": "146",
+				"2.0": "359",
+				"2.5": "763",
+				"3.0": "2331",
+				"3.5": "3034",
+				"4.0": "5619",
+				"4.5": "5951",
+				"5.0": "12878",
+			},
+			StartDate: Date{2013, time.April, 7},
+			EndDate:   Date{2013, time.September, 28},
+			PosterImage: &Image{
+				Original: "https://static.hummingbird.me/anime/7442/poster/$1.png",
+			},
+			CoverImage: &Image{
+				Original: "https://static.hummingbird.me/anime/7442/cover/$1.png",
+			},
+			AgeRating:      "R",
+			AgeRatingGuide: "Violence, Profanity",
 		},
 		EpisodeCount:   25,
 		EpisodeLength:  24,
 		Subtype:        AnimeSubtypeTV,
 		YoutubeVideoID: "n4Nj6Y_SNYI",
-		AgeRating:      "R",
-		AgeRatingGuide: "Violence, Profanity",
 	}
 	if !reflect.DeepEqual(got, want) {
 		t.Errorf("Anime.Show decode attributes mismatch\nhave: %#+v\nwant: %#+v", got, want)
@@ -153,7 +157,7 @@ func TestAnimeService_Show_notFound(t *testing.T) {
 		http.Error(w, `{"errors":[{"title":"Record not found","detail":"The record identified by 0 could not be found.","code":"404","status":"404"}]}`, http.StatusNotFound)
 	})
 
-	_, resp, err := client.Anime.Show("0")
+	_, resp, err := client.Anime.Show(context.Background(), "0")
 	if err == nil {
 		t.Error("Expected HTTP 404 error.")
 	}
@@ -164,7 +168,7 @@ func TestAnimeService_Show_notFound(t *testing.T) {
 }
 
 func TestAnimeService_Show_invalidID(t *testing.T) {
-	_, _, err := client.Anime.Show("%", nil)
+	_, _, err := client.Anime.Show(context.Background(), "%", nil)
 	if err == nil {
 		t.Errorf("Expected error to be returned")
 	}
@@ -215,6 +219,7 @@ func TestAnimeService_List(t *testing.T) {
 	})
 
 	got, resp, err := client.Anime.List(
+		context.Background(),
 		Pagination(2, 0),
 		Filter("genres", "sports", "sci-fi"),
 		Sort("-followersCount", "-followingCount"),
@@ -225,8 +230,8 @@ func TestAnimeService_List(t *testing.T) {
 	}
 
 	want := []*Anime{
-		{ID: "7442", Slug: "attack-on-titan", Subtype: AnimeSubtypeTV},
-		{ID: "7442", Slug: "attack-on-titan", Subtype: AnimeSubtypeTV},
+		{ID: "7442", Media: Media{Slug: "attack-on-titan"}, Subtype: AnimeSubtypeTV},
+		{ID: "7442", Media: Media{Slug: "attack-on-titan"}, Subtype: AnimeSubtypeTV},
 	}
 	if !reflect.DeepEqual(got, want) {
 		t.Errorf("Anime.List mismatch\nhave: %#+v\nwant: %#+v", got, want)
@@ -257,7 +262,7 @@ func TestAnimeService_List_filterOptionWithUnknownAttribute(t *testing.T) {
 		fmt.Fprint(w, s)
 	})
 
-	_, _, err := client.Anime.List(Filter("unknown_attribute", "unknown_value"))
+	_, _, err := client.Anime.List(context.Background(), Filter("unknown_attribute", "unknown_value"))
 	if err == nil {
 		t.Fatal("Anime.List with unknown filter expected to return err")
 	}
@@ -271,8 +276,6 @@ func TestAnimeService_List_filterOptionWithUnknownAttribute(t *testing.T) {
 	}
 }
 
-// TODO: This test will fail once we ask google/jsonapi to unmashal a struct
-// field like Charater.Image thus such cases will remain unsupported for now.
 func TestAnimeService_List_include(t *testing.T) {
 	setup()
 	defer teardown()
@@ -376,13 +379,13 @@ func TestAnimeService_List_include(t *testing.T) {
 		fmt.Fprint(w, s)
 	})
 
-	got, resp, err := client.Anime.List(Include("castings.character", "castings.person"))
+	got, resp, err := client.Anime.List(context.Background(), Include("castings.character", "castings.person"))
 	if err != nil {
 		t.Errorf("Anime.List returned error: %v", err)
 	}
 
 	want := []*Anime{
-		{ID: "1", Slug: "cowboy-bebop",
+		{ID: "1", Media: Media{Slug: "cowboy-bebop"},
 			Castings: []*Casting{
 				{
 					ID:   "47",
@@ -390,12 +393,12 @@ func TestAnimeService_List_include(t *testing.T) {
 					Language: "Japanese",
 					Person:   &Person{ID: "47", Name: "Kouichi Yamadera", MALID: "11"},
 					Character: &Character{ID: "2", Name: "Spike Spiegel", MALID: 1,
-						Image: map[string]interface{}{"original": "https://media.kitsu.io/characters/images/2/original.jpg?1483096805"},
+						Image: &Image{Original: "https://media.kitsu.io/characters/images/2/original.jpg?1483096805"},
 					},
 				},
 			},
 		},
-		{ID: "1", Slug: "cowboy-bebop",
+		{ID: "1", Media: Media{Slug: "cowboy-bebop"},
 			Castings: []*Casting{
 				{
 					ID:   "47",
@@ -403,7 +406,7 @@ func TestAnimeService_List_include(t *testing.T) {
 					Language: "Japanese",
 					Person:   &Person{ID: "47", Name: "Kouichi Yamadera", MALID: "11"},
 					Character: &Character{ID: "2", Name: "Spike Spiegel", MALID: 1,
-						Image: map[string]interface{}{"original": "https://media.kitsu.io/characters/images/2/original.jpg?1483096805"},
+						Image: &Image{Original: "https://media.kitsu.io/characters/images/2/original.jpg?1483096805"},
 					},
 				},
 			},
@@ -417,8 +420,44 @@ func TestAnimeService_List_include(t *testing.T) {
 		fmt.Println(string(data))
 	}
 
+	if got[0].Castings[0] != got[1].Castings[0] {
+		t.Error("Anime.List decoded separate values for the same casting")
+	}
+	if len(resp.Included) != 0 {
+		t.Errorf("Anime.List response Included = %#v, want none", resp.Included)
+	}
+
 	offset := PageOffset{First: 0, Last: 498, Next: 50, Prev: 0}
 	if got, want := resp.Offset, offset; got != want {
 		t.Errorf("Anime.List response Offset = %+v, want %+v", got, want)
 	}
 }
+
+func TestAnimeService_List_fields(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/"+defaultAPIVersion+"anime", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"include":        "genres",
+			"fields[anime]":  "canonicalTitle,episodeCount,genres",
+			"fields[genres]": "name",
+		})
+		fmt.Fprint(w, `{"data":[{"id":"1","type":"anime","attributes":{"canonicalTitle":"Cowboy Bebop","episodeCount":26}}]}`)
+	})
+
+	got, _, err := client.Anime.List(
+		context.Background(),
+		Include("genres"),
+		AnimeFields(AnimeFieldCanonicalTitle, AnimeFieldEpisodeCount, AnimeFieldGenres),
+		Fields("genres", "name"),
+	)
+	if err != nil {
+		t.Fatalf("Anime.List returned err: %v", err)
+	}
+	want := []*Anime{{ID: "1", Media: Media{CanonicalTitle: "Cowboy Bebop"}, EpisodeCount: 26}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Anime.List with fields\nhave: %#v\nwant: %#v", got, want)
+	}
+}
@@ -0,0 +1,165 @@
+// Package auth provides OAuth2 authentication for the Kitsu API.
+//
+// Kitsu authenticates users with the OAuth2 password grant. This package
+// performs the grant, refreshes the token when it expires and persists it
+// through a TokenStore so that later runs can skip logging in:
+//
+//	cfg := &auth.Config{Store: auth.NewFileStore("kitsu-token.json")}
+//	c, err := auth.NewClient(ctx, cfg, username, password)
+//	if err != nil {
+//		// Handle error.
+//	}
+//	anime, _, err := c.Anime.List(ctx)
+//
+// Kitsu Authentication docs:
+// https://kitsu.docs.apiary.io/#introduction/authentication
+package auth
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/nstratos/go-kitsu/kitsu"
+	"golang.org/x/oauth2"
+)
+
+// TokenURL is the URL of the Kitsu OAuth2 token endpoint.
+const TokenURL = "https://kitsu.io/api/oauth/token"
+
+// Config describes how to authenticate with Kitsu. The zero value is ready to
+// use and keeps the token only in memory.
+type Config struct {
+	// ClientID and ClientSecret identify the application. Kitsu does not
+	// require them for the password grant so they can be left empty.
+	ClientID     string
+	ClientSecret string
+
+	// TokenURL is the token endpoint. If empty, TokenURL is used.
+	TokenURL string
+
+	// Store persists the token after logging in and after each refresh. If
+	// nil, the token is not persisted.
+	Store TokenStore
+}
+
+func (c *Config) oauth2Config() *oauth2.Config {
+	tokenURL := c.TokenURL
+	if tokenURL == "" {
+		tokenURL = TokenURL
+	}
+	return &oauth2.Config{
+		ClientID:     c.ClientID,
+		ClientSecret: c.ClientSecret,
+		Endpoint:     oauth2.Endpoint{TokenURL: tokenURL},
+	}
+}
+
+// Login performs the password grant with the email or slug and password of a
+// Kitsu account and returns the resulting token. The token is also saved to
+// the Store if one is set.
+func (c *Config) Login(ctx context.Context, username, password string) (*oauth2.Token, error) {
+	tok, err := c.oauth2Config().PasswordCredentialsToken(ctx, username, password)
+	if err != nil {
+		return nil, fmt.Errorf("auth: password grant: %w", err)
+	}
+	if c.Store != nil {
+		if err := c.Store.SaveToken(tok); err != nil {
+			return nil, err
+		}
+	}
+	return tok, nil
+}
+
+// TokenSource returns a TokenSource that returns tok until it expires and
+// then refreshes it using its refresh token. Refreshed tokens are saved to the
+// Store if one is set.
+//
+// The HTTP client used for refreshing is taken from ctx the same way as
+// oauth2.Config.TokenSource does.
+func (c *Config) TokenSource(ctx context.Context, tok *oauth2.Token) oauth2.TokenSource {
+	src := c.oauth2Config().TokenSource(ctx, tok)
+	if c.Store == nil {
+		return src
+	}
+	return &storingTokenSource{src: src, store: c.Store, last: tok.AccessToken}
+}
+
+// NewClient returns a Kitsu client that authenticates its requests.
+//
+// It first tries the token of cfg.Store, refreshing it if it has expired.
+// If the store holds no token or the token can no longer be refreshed, it
+// logs in with username and password. In that case, an empty username results
+// in an error. If cfg is nil, the zero Config is used.
+func NewClient(ctx context.Context, cfg *Config, username, password string) (*kitsu.Client, error) {
+	if cfg == nil {
+		cfg = new(Config)
+	}
+
+	ts, err := cfg.storedTokenSource(ctx)
+	if err != nil {
+		if username == "" {
+			return nil, err
+		}
+		tok, err := cfg.Login(ctx, username, password)
+		if err != nil {
+			return nil, err
+		}
+		ts = cfg.TokenSource(ctx, tok)
+	}
+	ts = oauth2.ReuseTokenSource(nil, ts)
+	c := kitsu.NewClient(oauth2.NewClient(ctx, ts))
+	// The Authorization header is added by the transport, after the Cache
+	// of the client is consulted, so the responses are scoped by token here.
+	c.CacheScope = func(*http.Request) string {
+		tok, err := ts.Token()
+		if err != nil {
+			return ""
+		}
+		return tok.AccessToken
+	}
+	return c, nil
+}
+
+// storedTokenSource returns a TokenSource for the token of the Store after
+// making sure that it is valid or that it can be refreshed.
+func (c *Config) storedTokenSource(ctx context.Context) (oauth2.TokenSource, error) {
+	if c.Store == nil {
+		return nil, ErrNoToken
+	}
+	tok, err := c.Store.Token()
+	if err != nil {
+		return nil, err
+	}
+	ts := c.TokenSource(ctx, tok)
+	if _, err := ts.Token(); err != nil {
+		return nil, fmt.Errorf("auth: refreshing stored token: %w", err)
+	}
+	return ts, nil
+}
+
+// storingTokenSource saves each new token that src returns to store.
+type storingTokenSource struct {
+	src   oauth2.TokenSource
+	store TokenStore
+
+	mu   sync.Mutex
+	last string // Access token of the last saved token.
+}
+
+func (s *storingTokenSource) Token() (*oauth2.Token, error) {
+	tok, err := s.src.Token()
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if tok.AccessToken != s.last {
+		if err := s.store.SaveToken(tok); err != nil {
+			return nil, fmt.Errorf("auth: saving refreshed token: %w", err)
+		}
+		s.last = tok.AccessToken
+	}
+	return tok, nil
+}
@@ -0,0 +1,190 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+// newTokenServer returns a test server that implements the password and
+// refresh token grants as well as a users endpoint that echoes the
+// Authorization header as the user name. Each issued access token is unique
+// and the number of issued tokens is counted.
+func newTokenServer(t *testing.T) (*httptest.Server, *int32) {
+	t.Helper()
+	var issued int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("/oauth/token", func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Error(err)
+			return
+		}
+		switch r.Form.Get("grant_type") {
+		case "password":
+			if r.Form.Get("username") != "gopher" || r.Form.Get("password") != "secret" {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprint(w, `{"error":"invalid_grant"}`)
+				return
+			}
+		case "refresh_token":
+			if r.Form.Get("refresh_token") != "refresh" {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusBadRequest)
+				fmt.Fprint(w, `{"error":"invalid_grant"}`)
+				return
+			}
+		default:
+			t.Errorf("unexpected grant_type %q", r.Form.Get("grant_type"))
+		}
+		n := atomic.AddInt32(&issued, 1)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"access_token":"access%d","token_type":"bearer","expires_in":3600,"refresh_token":"refresh"}`, n)
+	})
+	mux.HandleFunc("/api/edge/users", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"data":[{"type":"users","id":"1","attributes":{"name":%q}}]}`, r.Header.Get("Authorization"))
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv, &issued
+}
+
+func TestConfig_Login(t *testing.T) {
+	srv, _ := newTokenServer(t)
+	store := new(MemoryStore)
+	cfg := &Config{TokenURL: srv.URL + "/oauth/token", Store: store}
+
+	tok, err := cfg.Login(context.Background(), "gopher", "secret")
+	if err != nil {
+		t.Fatalf("Login returned err: %v", err)
+	}
+	if got, want := tok.AccessToken, "access1"; got != want {
+		t.Errorf("Login access token = %q, want %q", got, want)
+	}
+	saved, err := store.Token()
+	if err != nil {
+		t.Fatalf("Login did not save token: %v", err)
+	}
+	if got, want := saved.AccessToken, tok.AccessToken; got != want {
+		t.Errorf("saved access token = %q, want %q", got, want)
+	}
+}
+
+func TestConfig_Login_badCredentials(t *testing.T) {
+	srv, _ := newTokenServer(t)
+	store := new(MemoryStore)
+	cfg := &Config{TokenURL: srv.URL + "/oauth/token", Store: store}
+
+	if _, err := cfg.Login(context.Background(), "gopher", "wrong"); err == nil {
+		t.Fatal("Login with bad credentials expected to return err")
+	}
+	if _, err := store.Token(); !errors.Is(err, ErrNoToken) {
+		t.Errorf("Login with bad credentials saved a token")
+	}
+}
+
+func TestConfig_TokenSource_refresh(t *testing.T) {
+	srv, _ := newTokenServer(t)
+	store := new(MemoryStore)
+	cfg := &Config{TokenURL: srv.URL + "/oauth/token", Store: store}
+
+	expired := &oauth2.Token{
+		AccessToken:  "expired",
+		RefreshToken: "refresh",
+		Expiry:       time.Now().Add(-time.Hour),
+	}
+	tok, err := cfg.TokenSource(context.Background(), expired).Token()
+	if err != nil {
+		t.Fatalf("TokenSource.Token returned err: %v", err)
+	}
+	if got, want := tok.AccessToken, "access1"; got != want {
+		t.Errorf("refreshed access token = %q, want %q", got, want)
+	}
+	saved, err := store.Token()
+	if err != nil {
+		t.Fatalf("refreshed token was not saved: %v", err)
+	}
+	if got, want := saved.AccessToken, "access1"; got != want {
+		t.Errorf("saved access token = %q, want %q", got, want)
+	}
+}
+
+func TestNewClient(t *testing.T) {
+	srv, issued := newTokenServer(t)
+	store := new(MemoryStore)
+	cfg := &Config{TokenURL: srv.URL + "/oauth/token", Store: store}
+	ctx := context.Background()
+
+	// The first client logs in and the second reuses the stored token.
+	for i := 0; i < 2; i++ {
+		c, err := NewClient(ctx, cfg, "gopher", "secret")
+		if err != nil {
+			t.Fatalf("NewClient returned err: %v", err)
+		}
+		c.BaseURL, _ = url.Parse(srv.URL + "/")
+		users, _, err := c.User.List(ctx)
+		if err != nil {
+			t.Fatalf("User.List returned err: %v", err)
+		}
+		if got, want := users[0].Name, "Bearer access1"; got != want {
+			t.Errorf("Authorization header = %q, want %q", got, want)
+		}
+		if c.CacheScope == nil {
+			t.Fatal("NewClient returned client without CacheScope")
+		}
+		if got, want := c.CacheScope(nil), "access1"; got != want {
+			t.Errorf("CacheScope = %q, want %q", got, want)
+		}
+	}
+	if got, want := atomic.LoadInt32(issued), int32(1); got != want {
+		t.Errorf("issued %d tokens, want %d", got, want)
+	}
+}
+
+func TestNewClient_refreshStoredToken(t *testing.T) {
+	srv, _ := newTokenServer(t)
+	store := new(MemoryStore)
+	store.SaveToken(&oauth2.Token{AccessToken: "expired", RefreshToken: "refresh", Expiry: time.Now().Add(-time.Hour)})
+	cfg := &Config{TokenURL: srv.URL + "/oauth/token", Store: store}
+
+	if _, err := NewClient(context.Background(), cfg, "", ""); err != nil {
+		t.Fatalf("NewClient returned err: %v", err)
+	}
+	saved, _ := store.Token()
+	if got, want := saved.AccessToken, "access1"; got != want {
+		t.Errorf("saved access token = %q, want %q", got, want)
+	}
+}
+
+func TestNewClient_revokedStoredToken(t *testing.T) {
+	srv, _ := newTokenServer(t)
+	store := new(MemoryStore)
+	store.SaveToken(&oauth2.Token{AccessToken: "expired", RefreshToken: "revoked", Expiry: time.Now().Add(-time.Hour)})
+	cfg := &Config{TokenURL: srv.URL + "/oauth/token", Store: store}
+	ctx := context.Background()
+
+	if _, err := NewClient(ctx, cfg, "", ""); err == nil {
+		t.Error("NewClient with revoked token and no credentials expected to return err")
+	}
+	if _, err := NewClient(ctx, cfg, "gopher", "secret"); err != nil {
+		t.Fatalf("NewClient with revoked token and credentials returned err: %v", err)
+	}
+	saved, _ := store.Token()
+	if got, want := saved.AccessToken, "access1"; got != want {
+		t.Errorf("saved access token = %q, want %q", got, want)
+	}
+}
+
+func TestNewClient_noToken(t *testing.T) {
+	if _, err := NewClient(context.Background(), nil, "", ""); !errors.Is(err, ErrNoToken) {
+		t.Errorf("NewClient without token or credentials returned err %v, want %v", err, ErrNoToken)
+	}
+}
@@ -0,0 +1,123 @@
+package auth
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"golang.org/x/oauth2"
+)
+
+// ErrNoToken is returned by a TokenStore when it does not hold a token yet.
+var ErrNoToken = errors.New("auth: no token stored")
+
+// TokenStore persists the OAuth2 token of a Kitsu account so that it can be
+// reused across program runs instead of logging in each time.
+//
+// Implementations must be safe for concurrent use.
+type TokenStore interface {
+	// Token returns the stored token or ErrNoToken if there is none.
+	Token() (*oauth2.Token, error)
+
+	// SaveToken stores tok, replacing any previously stored token.
+	SaveToken(tok *oauth2.Token) error
+}
+
+// MemoryStore is a TokenStore that keeps the token in memory. The zero value
+// is an empty store ready to use.
+type MemoryStore struct {
+	mu  sync.Mutex
+	tok *oauth2.Token
+}
+
+// Token returns the stored token or ErrNoToken if there is none.
+func (s *MemoryStore) Token() (*oauth2.Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.tok == nil {
+		return nil, ErrNoToken
+	}
+	tok := *s.tok
+	return &tok, nil
+}
+
+// SaveToken stores a copy of tok.
+func (s *MemoryStore) SaveToken(tok *oauth2.Token) error {
+	if tok == nil {
+		return errors.New("auth: cannot save nil token")
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t := *tok
+	s.tok = &t
+	return nil
+}
+
+// FileStore is a TokenStore that keeps the token as JSON in a file. Since the
+// token grants access to the account, the file is only readable and writable
+// by its owner.
+type FileStore struct {
+	mu   sync.Mutex
+	path string
+}
+
+// NewFileStore returns a FileStore that keeps the token in the file at path.
+// The file is created on the first save.
+func NewFileStore(path string) *FileStore {
+	return &FileStore{path: path}
+}
+
+// Token reads the token from the file. It returns ErrNoToken if the file does
+// not exist.
+func (s *FileStore) Token() (*oauth2.Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrNoToken
+	}
+	if err != nil {
+		return nil, fmt.Errorf("auth: reading token file: %v", err)
+	}
+	tok := new(oauth2.Token)
+	if err := json.Unmarshal(b, tok); err != nil {
+		return nil, fmt.Errorf("auth: unmarshaling token from %q: %v", s.path, err)
+	}
+	return tok, nil
+}
+
+// SaveToken writes tok to the file with 0600 permissions. The token is first
+// written to a temporary file in the same directory which then replaces the
+// file so that a failed write never leaves a corrupted token behind.
+func (s *FileStore) SaveToken(tok *oauth2.Token) error {
+	if tok == nil {
+		return errors.New("auth: cannot save nil token")
+	}
+	b, err := json.MarshalIndent(tok, "", "   ")
+	if err != nil {
+		return fmt.Errorf("auth: marshaling token: %v", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// os.CreateTemp creates the file with 0600 permissions.
+	f, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".tmp*")
+	if err != nil {
+		return fmt.Errorf("auth: creating token file: %v", err)
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.Write(b); err != nil {
+		f.Close()
+		return fmt.Errorf("auth: writing token file: %v", err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("auth: writing token file: %v", err)
+	}
+	if err := os.Rename(f.Name(), s.path); err != nil {
+		return fmt.Errorf("auth: saving token file: %v", err)
+	}
+	return nil
+}
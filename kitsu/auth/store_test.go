@@ -0,0 +1,91 @@
+package auth
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"testing"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+func testStore(t *testing.T, s TokenStore) {
+	t.Helper()
+	if _, err := s.Token(); !errors.Is(err, ErrNoToken) {
+		t.Fatalf("Token of empty store returned err %v, want %v", err, ErrNoToken)
+	}
+	for _, access := range []string{"first", "second"} {
+		want := &oauth2.Token{
+			AccessToken:  access,
+			TokenType:    "bearer",
+			RefreshToken: "refresh",
+			Expiry:       time.Date(2018, 2, 19, 17, 44, 36, 0, time.UTC),
+		}
+		if err := s.SaveToken(want); err != nil {
+			t.Fatalf("SaveToken returned err: %v", err)
+		}
+		got, err := s.Token()
+		if err != nil {
+			t.Fatalf("Token returned err: %v", err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Token \nhave: %#v\nwant: %#v", got, want)
+		}
+	}
+	if err := s.SaveToken(nil); err == nil {
+		t.Error("SaveToken(nil) expected to return err")
+	}
+}
+
+func TestMemoryStore(t *testing.T) {
+	testStore(t, new(MemoryStore))
+}
+
+func TestFileStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token.json")
+	testStore(t, NewFileStore(path))
+
+	if runtime.GOOS == "windows" {
+		return
+	}
+	fi, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := fi.Mode().Perm(), os.FileMode(0600); got != want {
+		t.Errorf("token file permissions = %v, want %v", got, want)
+	}
+}
+
+func TestFileStore_existingFilePermissions(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("file permissions are not supported on windows")
+	}
+	path := filepath.Join(t.TempDir(), "token.json")
+	if err := os.WriteFile(path, []byte("{}"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := NewFileStore(path).SaveToken(&oauth2.Token{AccessToken: "a"}); err != nil {
+		t.Fatalf("SaveToken returned err: %v", err)
+	}
+	fi, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := fi.Mode().Perm(), os.FileMode(0600); got != want {
+		t.Errorf("token file permissions = %v, want %v", got, want)
+	}
+}
+
+func TestFileStore_badToken(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token.json")
+	if err := os.WriteFile(path, []byte("not json"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewFileStore(path).Token(); err == nil || errors.Is(err, ErrNoToken) {
+		t.Errorf("Token with malformed file returned err %v, want unmarshal error", err)
+	}
+}
@@ -0,0 +1,122 @@
+package kitsu
+
+import (
+	"context"
+	"sync"
+)
+
+const (
+	// batchSize is the maximum number of IDs requested with each call of
+	// GetMany. It matches the maximum page limit of the Kitsu API so that a
+	// chunk always fits in a single page.
+	batchSize = 20
+
+	// batchIDsLength is the maximum length of the comma-separated IDs of a
+	// chunk, which keeps the request URL well below common URL length limits.
+	batchIDsLength = 1000
+
+	// batchConcurrency is the maximum number of chunks requested at the same
+	// time by GetMany.
+	batchConcurrency = 4
+)
+
+// getMany retrieves the resources identified by ids using list with the
+// id filter. It requests the IDs in chunks, runs up to batchConcurrency
+// chunks concurrently and returns the resources in the order of ids, with a
+// nil in place of each resource that was not found. The IDs that were not
+// found are also returned in the order of ids.
+//
+// If a chunk fails, the remaining chunks are canceled and the error is
+// returned.
+func getMany[T any](ctx context.Context, list listFunc[*T], idOf func(*T) string, ids []string, opts []URLOption) ([]*T, []string, error) {
+	if len(ids) == 0 {
+		return nil, nil, nil
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		mu       sync.Mutex
+		found    = make(map[string]*T, len(ids))
+		firstErr error
+		wg       sync.WaitGroup
+		sem      = make(chan struct{}, batchConcurrency)
+	)
+	for _, chunk := range chunkIDs(ids) {
+		wg.Add(1)
+		go func(chunk []string) {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+				return
+			}
+			chunkOpts := append(opts[:len(opts):len(opts)], Filter("id", chunk...), Limit(len(chunk)))
+			items, _, err := list(ctx, chunkOpts...)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				if firstErr == nil {
+					firstErr = err
+					cancel()
+				}
+				return
+			}
+			for _, item := range items {
+				found[idOf(item)] = item
+			}
+		}(chunk)
+	}
+	wg.Wait()
+	if firstErr != nil {
+		return nil, nil, firstErr
+	}
+	// The chunks that did not get to run because ctx was done before they
+	// acquired the semaphore are unaccounted for.
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	results := make([]*T, len(ids))
+	var notFound []string
+	for i, id := range ids {
+		if item, ok := found[id]; ok {
+			results[i] = item
+		} else {
+			notFound = append(notFound, id)
+		}
+	}
+	return results, notFound, nil
+}
+
+// chunkIDs splits the unique ids into chunks of at most batchSize IDs whose
+// comma-separated length does not exceed batchIDsLength.
+func chunkIDs(ids []string) [][]string {
+	var (
+		chunks [][]string
+		chunk  []string
+		length int
+		seen   = make(map[string]bool, len(ids))
+	)
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		if len(chunk) > 0 && (len(chunk) == batchSize || length+1+len(id) > batchIDsLength) {
+			chunks = append(chunks, chunk)
+			chunk, length = nil, 0
+		}
+		if len(chunk) > 0 {
+			length++ // Comma.
+		}
+		chunk = append(chunk, id)
+		length += len(id)
+	}
+	if len(chunk) > 0 {
+		chunks = append(chunks, chunk)
+	}
+	return chunks
+}
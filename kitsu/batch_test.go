@@ -0,0 +1,232 @@
+package kitsu
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// serveByID serves the resources of resourceType whose IDs are requested
+// with the id filter, apart from those in missing. It records the requested
+// chunks.
+func serveByID(t *testing.T, path, resourceType string, missing map[string]bool) *[][]string {
+	var (
+		mu     sync.Mutex
+		chunks [][]string
+	)
+	mux.HandleFunc("/"+defaultAPIVersion+path, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		ids := strings.Split(r.FormValue("filter[id]"), ",")
+		if got, want := r.FormValue("page[limit]"), strconv.Itoa(len(ids)); got != want {
+			t.Errorf("page[limit] = %q, want %q", got, want)
+		}
+		mu.Lock()
+		chunks = append(chunks, ids)
+		mu.Unlock()
+
+		var data []string
+		for _, id := range ids {
+			if !missing[id] {
+				data = append(data, fmt.Sprintf(`{"id":%q,"type":%q}`, id, resourceType))
+			}
+		}
+		fmt.Fprintf(w, `{"data":[%s]}`, strings.Join(data, ","))
+	})
+	return &chunks
+}
+
+func TestAnimeService_GetMany(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var ids []string
+	for i := 1; i <= 45; i++ {
+		ids = append(ids, strconv.Itoa(i))
+	}
+	// Duplicates are requested once and filled in each position.
+	ids = append(ids, "3")
+	chunks := serveByID(t, "anime", "anime", map[string]bool{"7": true, "44": true})
+
+	got, notFound, err := client.Anime.GetMany(context.Background(), ids)
+	if err != nil {
+		t.Fatalf("Anime.GetMany returned err: %v", err)
+	}
+
+	if got, want := len(*chunks), 3; got != want {
+		t.Errorf("Anime.GetMany made %d requests, want %d", got, want)
+	}
+	for _, c := range *chunks {
+		if len(c) > batchSize {
+			t.Errorf("Anime.GetMany requested %d IDs at once, want at most %d", len(c), batchSize)
+		}
+	}
+	if len(got) != len(ids) {
+		t.Fatalf("Anime.GetMany returned %d results, want %d", len(got), len(ids))
+	}
+	for i, a := range got {
+		switch ids[i] {
+		case "7", "44":
+			if a != nil {
+				t.Errorf("result %d = %#v, want nil", i, a)
+			}
+		default:
+			if a == nil || a.ID != ids[i] {
+				t.Errorf("result %d = %#v, want anime %s", i, a, ids[i])
+			}
+		}
+	}
+	if want := []string{"7", "44"}; !reflect.DeepEqual(notFound, want) {
+		t.Errorf("Anime.GetMany not found = %v, want %v", notFound, want)
+	}
+}
+
+func TestAnimeService_GetMany_options(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/"+defaultAPIVersion+"anime", func(w http.ResponseWriter, r *http.Request) {
+		testFormValues(t, r, values{
+			"filter[id]":  "1,2",
+			"page[limit]": "2",
+			"include":     "genres",
+		})
+		fmt.Fprint(w, `{"data":[{"id":"2","type":"anime"},{"id":"1","type":"anime"}]}`)
+	})
+
+	got, notFound, err := client.Anime.GetMany(context.Background(), []string{"1", "2"}, Include("genres"), Limit(50))
+	if err != nil {
+		t.Fatalf("Anime.GetMany returned err: %v", err)
+	}
+	want := []*Anime{{ID: "1"}, {ID: "2"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Anime.GetMany\nhave: %#v\nwant: %#v", got, want)
+	}
+	if notFound != nil {
+		t.Errorf("Anime.GetMany not found = %v, want none", notFound)
+	}
+}
+
+func TestAnimeService_GetMany_error(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/"+defaultAPIVersion+"anime", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"errors":[{"status":"400","title":"Bad Request"}]}`, http.StatusBadRequest)
+	})
+
+	got, _, err := client.Anime.GetMany(context.Background(), []string{"1", "2"})
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) {
+		t.Fatalf("Anime.GetMany returned err %v, want *ErrorResponse", err)
+	}
+	if got != nil {
+		t.Errorf("Anime.GetMany with error returned %v, want nil", got)
+	}
+}
+
+func TestAnimeService_GetMany_canceled(t *testing.T) {
+	setup()
+	defer teardown()
+	serveByID(t, "anime", "anime", nil)
+
+	// Two chunks, each of which may either fail with the context error or
+	// never acquire the semaphore, so the iterations cover both.
+	var ids []string
+	for i := 1; i <= batchSize+1; i++ {
+		ids = append(ids, strconv.Itoa(i))
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	for i := 0; i < 50; i++ {
+		got, notFound, err := client.Anime.GetMany(ctx, ids)
+		if !errors.Is(err, context.Canceled) {
+			t.Fatalf("Anime.GetMany with canceled context returned err %v, want %v", err, context.Canceled)
+		}
+		if got != nil || notFound != nil {
+			t.Errorf("Anime.GetMany with canceled context returned %d results and %d not found, want none", len(got), len(notFound))
+		}
+	}
+}
+
+func TestAnimeService_GetMany_noIDs(t *testing.T) {
+	setup()
+	defer teardown()
+
+	got, notFound, err := client.Anime.GetMany(context.Background(), nil)
+	if got != nil || notFound != nil || err != nil {
+		t.Errorf("Anime.GetMany(nil) = %v, %v, %v, want nil, nil, nil", got, notFound, err)
+	}
+}
+
+func TestUserService_GetMany(t *testing.T) {
+	setup()
+	defer teardown()
+	serveByID(t, "users", "users", map[string]bool{"2": true})
+
+	got, notFound, err := client.User.GetMany(context.Background(), []string{"3", "2", "1"})
+	if err != nil {
+		t.Fatalf("User.GetMany returned err: %v", err)
+	}
+	want := []*User{{ID: "3"}, nil, {ID: "1"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("User.GetMany\nhave: %#v\nwant: %#v", got, want)
+	}
+	if want := []string{"2"}; !reflect.DeepEqual(notFound, want) {
+		t.Errorf("User.GetMany not found = %v, want %v", notFound, want)
+	}
+}
+
+func TestLibraryService_GetMany(t *testing.T) {
+	setup()
+	defer teardown()
+	serveByID(t, "library-entries", "libraryEntries", nil)
+
+	got, notFound, err := client.Library.GetMany(context.Background(), []string{"5269457", "747296"})
+	if err != nil {
+		t.Fatalf("Library.GetMany returned err: %v", err)
+	}
+	want := []*LibraryEntry{{ID: "5269457"}, {ID: "747296"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Library.GetMany\nhave: %#v\nwant: %#v", got, want)
+	}
+	if notFound != nil {
+		t.Errorf("Library.GetMany not found = %v, want none", notFound)
+	}
+}
+
+func Test_chunkIDs(t *testing.T) {
+	a, b := strings.Repeat("1", batchIDsLength/2), strings.Repeat("2", batchIDsLength/2)
+	tests := []struct {
+		name string
+		ids  []string
+		want [][]string
+	}{
+		{"empty", nil, nil},
+		{"duplicates", []string{"1", "2", "1"}, [][]string{{"1", "2"}}},
+		{"length", []string{a, b, "3"}, [][]string{{a}, {b, "3"}}},
+	}
+	for _, tt := range tests {
+		if got := chunkIDs(tt.ids); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("chunkIDs(%s) = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+
+	var ids []string
+	for i := 0; i < 2*batchSize+1; i++ {
+		ids = append(ids, strconv.Itoa(i))
+	}
+	chunks := chunkIDs(ids)
+	if got, want := len(chunks), 3; got != want {
+		t.Fatalf("chunkIDs of %d IDs returned %d chunks, want %d", len(ids), got, want)
+	}
+	if got, want := len(chunks[2]), 1; got != want {
+		t.Errorf("last chunk has %d IDs, want %d", got, want)
+	}
+}
@@ -0,0 +1,400 @@
+package kitsu
+
+import (
+	"bufio"
+	"bytes"
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Cache stores the raw responses of GET requests so that the Client can reuse
+// them. Keys are request URLs, followed by a hash of the scope of the response
+// if it has one. See Client.CacheScope. Implementations must be safe for
+// concurrent use.
+//
+// When Client.Cache is set, a cached response is reused without contacting
+// the API for as long as it is fresh according to the max-age directive of
+// its Cache-Control header. Once stale, it is revalidated with a conditional
+// request using its ETag or Last-Modified header and reused if the API
+// replies with 304 Not Modified. Responses with Cache-Control no-store are
+// never cached.
+//
+// A successful write, like LibraryService.Update, deletes the cached
+// responses of the resource type it modified, e.g. all the keys of
+// library-entries, for all scopes. Responses of other resource types that
+// include or link to the modified resource, e.g. users/1?include=libraryEntries
+// after a library entry is updated, are not deleted and may be served stale
+// until they expire. Callers that need them fresh should delete them or avoid
+// long max-age values for such requests.
+type Cache interface {
+	// Get returns the value stored for key, if any.
+	Get(key string) ([]byte, bool)
+
+	// Set stores value for key.
+	Set(key string, value []byte)
+
+	// Delete removes the value of key.
+	Delete(key string)
+
+	// DeletePrefix removes the values of all the keys that start with
+	// prefix.
+	DeletePrefix(prefix string)
+}
+
+// LRUCache is an in-memory Cache that holds a limited number of responses. When
+// it is full, the least recently used response is evicted.
+type LRUCache struct {
+	mu       sync.Mutex
+	capacity int
+	ll       *list.List               // Most recently used at the front.
+	items    map[string]*list.Element // Values are *lruEntry.
+}
+
+type lruEntry struct {
+	key   string
+	value []byte
+}
+
+// NewLRUCache returns an LRUCache that holds up to capacity responses.
+func NewLRUCache(capacity int) *LRUCache {
+	return &LRUCache{
+		capacity: capacity,
+		ll:       list.New(),
+		items:    make(map[string]*list.Element),
+	}
+}
+
+// Get returns the value stored for key and marks it as recently used.
+func (c *LRUCache) Get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+	c.ll.MoveToFront(e)
+	return e.Value.(*lruEntry).value, true
+}
+
+// Set stores value for key, evicting the least recently used value if the
+// cache is full.
+func (c *LRUCache) Set(key string, value []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.items[key]; ok {
+		e.Value.(*lruEntry).value = value
+		c.ll.MoveToFront(e)
+		return
+	}
+	c.items[key] = c.ll.PushFront(&lruEntry{key: key, value: value})
+	for c.ll.Len() > c.capacity {
+		c.remove(c.ll.Back())
+	}
+}
+
+// Delete removes the value of key.
+func (c *LRUCache) Delete(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.items[key]; ok {
+		c.remove(e)
+	}
+}
+
+// DeletePrefix removes the values of all the keys that start with prefix.
+func (c *LRUCache) DeletePrefix(prefix string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key, e := range c.items {
+		if strings.HasPrefix(key, prefix) {
+			c.remove(e)
+		}
+	}
+}
+
+// Len returns the number of values in the cache.
+func (c *LRUCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.ll.Len()
+}
+
+func (c *LRUCache) remove(e *list.Element) {
+	c.ll.Remove(e)
+	delete(c.items, e.Value.(*lruEntry).key)
+}
+
+// DiskCache is a Cache that stores each response in a file of a directory so
+// that responses survive program restarts. Since responses can contain
+// private data of the authenticated user, the files are only readable and
+// writable by their owner.
+//
+// Errors while accessing the files are treated as cache misses.
+type DiskCache struct {
+	mu  sync.Mutex
+	dir string
+}
+
+// NewDiskCache returns a DiskCache that stores responses in dir. The
+// directory is created when the first response is stored.
+func NewDiskCache(dir string) *DiskCache {
+	return &DiskCache{dir: dir}
+}
+
+// filename returns the file of key. Keys are hashed since URLs contain
+// characters that are not allowed in file names. Each file holds the key on
+// its first line followed by the value. The key is kept so that DeletePrefix
+// can find the files to delete.
+func (c *DiskCache) filename(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(c.dir, hex.EncodeToString(sum[:]))
+}
+
+// Get returns the value stored for key.
+func (c *DiskCache) Get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	k, value, ok := c.read(c.filename(key))
+	if !ok || k != key {
+		return nil, false
+	}
+	return value, true
+}
+
+func (c *DiskCache) read(name string) (key string, value []byte, ok bool) {
+	b, err := os.ReadFile(name)
+	if err != nil {
+		return "", nil, false
+	}
+	k, value, ok := bytes.Cut(b, []byte("\n"))
+	return string(k), value, ok
+}
+
+// Set stores value for key.
+func (c *DiskCache) Set(key string, value []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := os.MkdirAll(c.dir, 0700); err != nil {
+		return
+	}
+	f, err := os.CreateTemp(c.dir, "tmp*")
+	if err != nil {
+		return
+	}
+	defer os.Remove(f.Name())
+	_, err = f.Write(append([]byte(key+"\n"), value...))
+	if cerr := f.Close(); err != nil || cerr != nil {
+		return
+	}
+	_ = os.Rename(f.Name(), c.filename(key))
+}
+
+// Delete removes the value of key.
+func (c *DiskCache) Delete(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	_ = os.Remove(c.filename(key))
+}
+
+// DeletePrefix removes the values of all the keys that start with prefix.
+func (c *DiskCache) DeletePrefix(prefix string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entries, err := os.ReadDir(c.dir)
+	if err != nil {
+		return
+	}
+	for _, e := range entries {
+		if e.IsDir() || strings.HasPrefix(e.Name(), "tmp") {
+			continue
+		}
+		name := filepath.Join(c.dir, e.Name())
+		if key, _, ok := c.read(name); ok && strings.HasPrefix(key, prefix) {
+			_ = os.Remove(name)
+		}
+	}
+}
+
+// cachedSend is like send but it serves GET requests from the Cache of the
+// client when possible and keeps the Cache up to date.
+func (c *Client) cachedSend(ctx context.Context, req *http.Request) (*http.Response, error) {
+	if c.Cache == nil {
+		return c.send(ctx, req)
+	}
+	if req.Method != http.MethodGet {
+		resp, err := c.send(ctx, req)
+		if err == nil && resp.StatusCode < 300 {
+			c.invalidate(req)
+		}
+		return resp, err
+	}
+
+	key := c.cacheKey(req)
+	cached := c.cachedResponse(key, req)
+	if cached != nil {
+		if isFresh(cached, time.Now()) {
+			return cached, nil
+		}
+		// Revalidate the stale response.
+		req = req.Clone(ctx)
+		if etag := cached.Header.Get("ETag"); etag != "" {
+			req.Header.Set("If-None-Match", etag)
+		}
+		if lm := cached.Header.Get("Last-Modified"); lm != "" {
+			req.Header.Set("If-Modified-Since", lm)
+		}
+	}
+
+	resp, err := c.send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if cached != nil && resp.StatusCode == http.StatusNotModified {
+		resp.Body.Close()
+		// Refresh the cached response with the headers of the 304 response
+		// which describe its new freshness.
+		for _, h := range []string{"Cache-Control", "Date", "ETag", "Expires", "Last-Modified"} {
+			if v := resp.Header.Get(h); v != "" {
+				cached.Header.Set(h, v)
+			}
+		}
+		c.store(key, cached)
+		return cached, nil
+	}
+	if cached != nil {
+		cached.Body.Close()
+	}
+	if resp.StatusCode == http.StatusOK && isCacheable(resp) {
+		c.store(key, resp)
+	}
+	return resp, nil
+}
+
+// cachedResponse returns the response stored for key or nil if there is none.
+func (c *Client) cachedResponse(key string, req *http.Request) *http.Response {
+	b, ok := c.Cache.Get(key)
+	if !ok {
+		return nil
+	}
+	resp, err := http.ReadResponse(bufio.NewReader(bytes.NewReader(b)), req)
+	if err != nil {
+		c.Cache.Delete(key)
+		return nil
+	}
+	return resp
+}
+
+// store saves resp in the Cache. The body of resp is read and replaced so that
+// it can still be read by the caller.
+func (c *Client) store(key string, resp *http.Response) {
+	if resp.Header.Get("Date") == "" {
+		resp.Header.Set("Date", time.Now().UTC().Format(http.TimeFormat))
+	}
+	b, err := httputil.DumpResponse(resp, true)
+	if err != nil {
+		return
+	}
+	c.Cache.Set(key, b)
+}
+
+// invalidate deletes the cached responses of the resource type that req
+// modified. The resource type is the first path segment after the API
+// version, e.g. library-entries for library-entries/5269457.
+func (c *Client) invalidate(req *http.Request) {
+	base := c.BaseURL.ResolveReference(&url.URL{Path: defaultAPIVersion})
+	p, ok := strings.CutPrefix(req.URL.Path, base.Path)
+	if !ok {
+		c.Cache.DeletePrefix(req.URL.String())
+		return
+	}
+	collection, _, _ := strings.Cut(p, "/")
+	u := base.String() + collection
+	c.Cache.Delete(u)
+	c.Cache.DeletePrefix(u + "#")
+	c.Cache.DeletePrefix(u + "/")
+	c.Cache.DeletePrefix(u + "?")
+}
+
+// cacheKey returns the key of the cached response of req. It is the URL of
+// req followed by a hash of its scope, if any, so that the responses of all
+// scopes can still be found by URL prefix.
+func (c *Client) cacheKey(req *http.Request) string {
+	var scope string
+	if c.CacheScope != nil {
+		scope = c.CacheScope(req)
+	} else {
+		scope = req.Header.Get("Authorization")
+	}
+	if scope == "" {
+		return req.URL.String()
+	}
+	sum := sha256.Sum256([]byte(scope))
+	return req.URL.String() + "#" + hex.EncodeToString(sum[:])
+}
+
+// cacheControl returns the directives of the Cache-Control header of h.
+// Directives without a value are mapped to the empty string.
+func cacheControl(h http.Header) map[string]string {
+	cc := make(map[string]string)
+	for _, part := range strings.Split(h.Get("Cache-Control"), ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		name, value, _ := strings.Cut(part, "=")
+		cc[strings.ToLower(strings.TrimSpace(name))] = strings.Trim(strings.TrimSpace(value), `"`)
+	}
+	return cc
+}
+
+// isCacheable reports whether resp can be stored. It must not forbid storing
+// and it must be either fresh for some time or revalidatable.
+func isCacheable(resp *http.Response) bool {
+	cc := cacheControl(resp.Header)
+	if _, ok := cc["no-store"]; ok {
+		return false
+	}
+	if maxAge(cc) > 0 {
+		return true
+	}
+	return resp.Header.Get("ETag") != "" || resp.Header.Get("Last-Modified") != ""
+}
+
+// isFresh reports whether the cached resp can be used without revalidation
+// at time now.
+func isFresh(resp *http.Response, now time.Time) bool {
+	cc := cacheControl(resp.Header)
+	if _, ok := cc["no-cache"]; ok {
+		return false
+	}
+	date, err := http.ParseTime(resp.Header.Get("Date"))
+	if err != nil {
+		return false
+	}
+	return now.Sub(date) < maxAge(cc)
+}
+
+// maxAge returns the max-age directive of cc or zero if it is missing or
+// malformed.
+func maxAge(cc map[string]string) time.Duration {
+	v, ok := cc["max-age"]
+	if !ok {
+		return 0
+	}
+	secs, err := strconv.Atoi(v)
+	if err != nil || secs < 0 {
+		return 0
+	}
+	return time.Duration(secs) * time.Second
+}
@@ -0,0 +1,324 @@
+package kitsu
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+)
+
+func testCache(t *testing.T, c Cache) {
+	t.Helper()
+	if _, ok := c.Get("a"); ok {
+		t.Error("Get on empty cache returned a value")
+	}
+	c.Set("a", []byte("1"))
+	c.Set("a", []byte("2"))
+	c.Set("a/b", []byte("3"))
+	c.Set("ab", []byte("4"))
+	c.Set("b", []byte("5"))
+	if got, ok := c.Get("a"); !ok || string(got) != "2" {
+		t.Errorf("Get(a) = %q, %v, want %q, true", got, ok, "2")
+	}
+
+	c.Delete("b")
+	if _, ok := c.Get("b"); ok {
+		t.Error("Get(b) after Delete returned a value")
+	}
+
+	c.DeletePrefix("a/")
+	if _, ok := c.Get("a/b"); ok {
+		t.Error("Get(a/b) after DeletePrefix(a/) returned a value")
+	}
+	for _, key := range []string{"a", "ab"} {
+		if _, ok := c.Get(key); !ok {
+			t.Errorf("DeletePrefix(a/) deleted %q", key)
+		}
+	}
+}
+
+func TestLRUCache(t *testing.T) {
+	testCache(t, NewLRUCache(10))
+}
+
+func TestLRUCache_evict(t *testing.T) {
+	c := NewLRUCache(2)
+	c.Set("a", []byte("1"))
+	c.Set("b", []byte("2"))
+	c.Get("a") // b is now the least recently used.
+	c.Set("c", []byte("3"))
+
+	if _, ok := c.Get("b"); ok {
+		t.Error("least recently used value was not evicted")
+	}
+	for _, key := range []string{"a", "c"} {
+		if _, ok := c.Get(key); !ok {
+			t.Errorf("value %q was evicted", key)
+		}
+	}
+	if got, want := c.Len(), 2; got != want {
+		t.Errorf("Len = %d, want %d", got, want)
+	}
+}
+
+func TestDiskCache(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "cache")
+	testCache(t, NewDiskCache(dir))
+
+	// Values survive across instances.
+	if got, ok := NewDiskCache(dir).Get("a"); !ok || string(got) != "2" {
+		t.Errorf("Get(a) on new instance = %q, %v, want %q, true", got, ok, "2")
+	}
+
+	if runtime.GOOS == "windows" {
+		return
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, e := range entries {
+		fi, err := e.Info()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := fi.Mode().Perm(), os.FileMode(0600); got != want {
+			t.Errorf("cache file permissions = %v, want %v", got, want)
+		}
+	}
+}
+
+func TestClient_Cache_maxAge(t *testing.T) {
+	setup()
+	defer teardown()
+	client.Cache = NewLRUCache(10)
+
+	var hits int
+	mux.HandleFunc("/"+defaultAPIVersion+"anime/1", func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		w.Header().Set("Cache-Control", "max-age=60")
+		fmt.Fprint(w, `{"data":{"id":"1","type":"anime","attributes":{"slug":"cowboy-bebop"}}}`)
+	})
+
+	for i := 0; i < 3; i++ {
+		a, _, err := client.Anime.Show(context.Background(), "1")
+		if err != nil {
+			t.Fatalf("Anime.Show returned err: %v", err)
+		}
+		if got, want := a.Slug, "cowboy-bebop"; got != want {
+			t.Errorf("Anime.Show slug = %q, want %q", got, want)
+		}
+	}
+	if got, want := hits, 1; got != want {
+		t.Errorf("server hits = %d, want %d", got, want)
+	}
+
+	// Different options result in a different cache key.
+	if _, _, err := client.Anime.Show(context.Background(), "1", Include("genres")); err != nil {
+		t.Fatalf("Anime.Show returned err: %v", err)
+	}
+	if got, want := hits, 2; got != want {
+		t.Errorf("server hits = %d, want %d", got, want)
+	}
+}
+
+func TestClient_Cache_revalidate(t *testing.T) {
+	const lastModified = "Mon, 19 Feb 2018 17:44:36 GMT"
+	tests := []struct {
+		name      string
+		header    string
+		value     string
+		condition string
+	}{
+		{"etag", "ETag", `W/"abc"`, "If-None-Match"},
+		{"last modified", "Last-Modified", lastModified, "If-Modified-Since"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setup()
+			defer teardown()
+			client.Cache = NewLRUCache(10)
+
+			var hits, notModified int
+			mux.HandleFunc("/"+defaultAPIVersion+"anime/1", func(w http.ResponseWriter, r *http.Request) {
+				hits++
+				w.Header().Set(tt.header, tt.value)
+				if r.Header.Get(tt.condition) == tt.value {
+					notModified++
+					w.WriteHeader(http.StatusNotModified)
+					return
+				}
+				fmt.Fprint(w, `{"data":{"id":"1","type":"anime","attributes":{"slug":"cowboy-bebop"}}}`)
+			})
+
+			for i := 0; i < 3; i++ {
+				a, resp, err := client.Anime.Show(context.Background(), "1")
+				if err != nil {
+					t.Fatalf("Anime.Show returned err: %v", err)
+				}
+				if got, want := resp.StatusCode, http.StatusOK; got != want {
+					t.Errorf("Anime.Show status = %d, want %d", got, want)
+				}
+				if got, want := a.Slug, "cowboy-bebop"; got != want {
+					t.Errorf("Anime.Show slug = %q, want %q", got, want)
+				}
+			}
+			if got, want := hits, 3; got != want {
+				t.Errorf("server hits = %d, want %d", got, want)
+			}
+			if got, want := notModified, 2; got != want {
+				t.Errorf("not modified responses = %d, want %d", got, want)
+			}
+		})
+	}
+}
+
+func TestClient_Cache_noStore(t *testing.T) {
+	setup()
+	defer teardown()
+	c := NewLRUCache(10)
+	client.Cache = c
+
+	mux.HandleFunc("/"+defaultAPIVersion+"users/1", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Cache-Control", "max-age=60, no-store")
+		fmt.Fprint(w, `{"data":{"id":"1","type":"users"}}`)
+	})
+
+	if _, _, err := client.User.Show(context.Background(), "1"); err != nil {
+		t.Fatalf("User.Show returned err: %v", err)
+	}
+	if got := c.Len(); got != 0 {
+		t.Errorf("cache holds %d responses, want 0", got)
+	}
+}
+
+func TestClient_Cache_invalidate(t *testing.T) {
+	setup()
+	defer teardown()
+	client.Cache = NewLRUCache(10)
+
+	var hits int
+	mux.HandleFunc("/"+defaultAPIVersion+"library-entries/1", func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		w.Header().Set("Cache-Control", "max-age=60")
+		fmt.Fprint(w, `{"data":{"id":"1","type":"libraryEntries","attributes":{"progress":1}}}`)
+	})
+	mux.HandleFunc("/"+defaultAPIVersion+"anime/1", func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		w.Header().Set("Cache-Control", "max-age=60")
+		fmt.Fprint(w, `{"data":{"id":"1","type":"anime"}}`)
+	})
+
+	ctx := context.Background()
+	show := func() {
+		t.Helper()
+		if _, _, err := client.Library.Show(ctx, "1"); err != nil {
+			t.Fatalf("Library.Show returned err: %v", err)
+		}
+		if _, _, err := client.Anime.Show(ctx, "1"); err != nil {
+			t.Fatalf("Anime.Show returned err: %v", err)
+		}
+	}
+	show()
+	show()
+	if got, want := hits, 2; got != want {
+		t.Errorf("server hits = %d, want %d", got, want)
+	}
+
+	// The update invalidates only the library entries.
+	if _, _, err := client.Library.Update(ctx, &LibraryEntry{ID: "1", Progress: 2}, []string{"progress"}); err != nil {
+		t.Fatalf("Library.Update returned err: %v", err)
+	}
+	show()
+	if got, want := hits, 4; got != want {
+		t.Errorf("server hits = %d, want %d", got, want)
+	}
+}
+
+func TestClient_Cache_scope(t *testing.T) {
+	setup()
+	defer teardown()
+	client.Cache = NewLRUCache(10)
+
+	var hits int
+	mux.HandleFunc("/"+defaultAPIVersion+"library-entries/1", func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		w.Header().Set("Cache-Control", "max-age=60")
+		fmt.Fprintf(w, `{"data":{"id":"1","type":"libraryEntries","attributes":{"notes":%q}}}`, r.Header.Get("Authorization"))
+	})
+
+	var token string
+	client.Use(func(next Handler) Handler {
+		return func(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
+			if token != "" {
+				req.Header.Set("Authorization", token)
+			}
+			return next(ctx, req, v)
+		}
+	})
+	show := func(want string) {
+		t.Helper()
+		e, _, err := client.Library.Show(context.Background(), "1")
+		if err != nil {
+			t.Fatalf("Library.Show returned err: %v", err)
+		}
+		if e.Notes != want {
+			t.Errorf("Library.Show with token %q returned the response of %q", token, e.Notes)
+		}
+	}
+
+	for _, token = range []string{"Bearer a", "Bearer b", "", "Bearer a", "Bearer b", ""} {
+		show(token)
+	}
+	if got, want := hits, 3; got != want {
+		t.Errorf("server hits = %d, want %d", got, want)
+	}
+
+	// A custom scope replaces the Authorization header.
+	client.CacheScope = func(*http.Request) string { return "user 1" }
+	token = "Bearer c"
+	show("Bearer c")
+	token = "Bearer d"
+	show("Bearer c")
+	if got, want := hits, 4; got != want {
+		t.Errorf("server hits = %d, want %d", got, want)
+	}
+
+	// Writes invalidate the responses of all scopes.
+	if _, _, err := client.Library.Update(context.Background(), &LibraryEntry{ID: "1", Progress: 2}, []string{"progress"}); err != nil {
+		t.Fatalf("Library.Update returned err: %v", err)
+	}
+	if got := client.Cache.(*LRUCache).Len(); got != 0 {
+		t.Errorf("cache holds %d responses after write, want 0", got)
+	}
+}
+
+func Test_isFresh(t *testing.T) {
+	now := time.Date(2018, 2, 19, 17, 44, 36, 0, time.UTC)
+	date := now.Add(-30 * time.Second).Format(http.TimeFormat)
+	tests := []struct {
+		cacheControl string
+		date         string
+		want         bool
+	}{
+		{"max-age=60", date, true},
+		{"public, max-age=60", date, true},
+		{"max-age=10", date, false},
+		{"max-age=60, no-cache", date, false},
+		{"max-age=bad", date, false},
+		{"", date, false},
+		{"max-age=60", "", false},
+	}
+	for _, tt := range tests {
+		resp := &http.Response{Header: http.Header{}}
+		resp.Header.Set("Cache-Control", tt.cacheControl)
+		resp.Header.Set("Date", tt.date)
+		if got := isFresh(resp, now); got != tt.want {
+			t.Errorf("isFresh(Cache-Control: %q, Date: %q) = %v, want %v", tt.cacheControl, tt.date, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package kitsu
+
+import (
+	"context"
+	"fmt"
+	"iter"
+)
+
+// CharacterService handles communication with the character related methods
+// of the Kitsu API.
+//
+// Kitsu API docs:
+// http://docs.kitsu.apiary.io/#reference/characters-&-people/characters
+type CharacterService service
+
+// Character represents a Kitsu character like the fictional characters that
+// appear in anime, manga and drama. Character is a relationship of Casting,
+// AnimeCharacter and MangaCharacter.
+//
+// Additional filters: name, slug
+type Character struct {
+	ID          string  `jsonapi:"primary,characters"`
+	Slug        string  `jsonapi:"attr,slug"`
+	Name        string  `jsonapi:"attr,name"`
+	MALID       float64 `jsonapi:"attr,malId"`
+	Description string  `jsonapi:"attr,description"`
+	Image       *Image  `jsonapi:"attr,image"`
+
+	// --- Relationships ---
+
+	// The media the character is best known for. Its concrete type is one of
+	// *Anime, *Manga or *Drama. See MediaResource.
+	PrimaryMedia MediaResource `jsonapi:"relation,primaryMedia,omitempty"`
+
+	// The appearances of the character in anime and manga. Include
+	// "animeCharacters.anime" or "mangaCharacters.manga" to retrieve the
+	// media as well.
+	AnimeCharacters []*AnimeCharacter `jsonapi:"relation,animeCharacters,omitempty"`
+	MangaCharacters []*MangaCharacter `jsonapi:"relation,mangaCharacters,omitempty"`
+
+	// Deprecated: Use AnimeCharacters instead.
+	Castings []*Casting `jsonapi:"relation,castings,omitempty"`
+}
+
+// Show returns details for a specific Character by providing a unique
+// identifier of the character e.g. 1.
+func (s *CharacterService) Show(ctx context.Context, characterID string, opts ...URLOption) (*Character, *Response, error) {
+	u := fmt.Sprintf(defaultAPIVersion+"characters/%s", characterID)
+
+	req, err := s.client.NewRequest("GET", u, nil, opts...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := new(Character)
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return c, resp, nil
+}
+
+// List returns a list of Character. Optional parameters can be specified to
+// filter the search results and control pagination, sorting etc.
+func (s *CharacterService) List(ctx context.Context, opts ...URLOption) ([]*Character, *Response, error) {
+	u := defaultAPIVersion + "characters"
+
+	req, err := s.client.NewRequest("GET", u, nil, opts...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var characters []*Character
+	resp, err := s.client.Do(ctx, req, &characters)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return characters, resp, nil
+}
+
+// Search returns a list of Character whose name matches name, e.g. Spike
+// Spiegel. It is like List with the name filter as characters do not support
+// the Search option.
+func (s *CharacterService) Search(ctx context.Context, name string, opts ...URLOption) ([]*Character, *Response, error) {
+	return s.List(ctx, append(opts[:len(opts):len(opts)], Filter("name", name))...)
+}
+
+// All returns an iterator over all the Character that match opts; see
+// AnimeService.All.
+func (s *CharacterService) All(ctx context.Context, opts ...URLOption) iter.Seq2[*Character, error] {
+	return all(ctx, s.List, opts)
+}
@@ -0,0 +1,145 @@
+package kitsu
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestCharacterService_Show(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/"+defaultAPIVersion+"characters/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", defaultMediaType)
+		testFormValues(t, r, values{
+			"include": "primaryMedia,animeCharacters.anime",
+		})
+		fmt.Fprint(w, `{
+			"data":{
+				"id":"1",
+				"type":"characters",
+				"attributes":{"slug":"spike-spiegel","name":"Spike Spiegel","malId":1},
+				"relationships":{
+					"primaryMedia":{"data":{"type":"anime","id":"1"}},
+					"animeCharacters":{"data":[{"type":"animeCharacters","id":"3"}]}
+				}
+			},
+			"included":[
+				{"id":"1","type":"anime","attributes":{"canonicalTitle":"Cowboy Bebop"}},
+				{
+					"id":"3",
+					"type":"animeCharacters",
+					"attributes":{"role":"main"},
+					"relationships":{
+						"anime":{"data":{"type":"anime","id":"1"}},
+						"character":{"data":{"type":"characters","id":"1"}}
+					}
+				}
+			]
+		}`)
+	})
+
+	got, _, err := client.Character.Show(context.Background(), "1", Include("primaryMedia", "animeCharacters.anime"))
+	if err != nil {
+		t.Fatalf("Character.Show returned error: %v", err)
+	}
+
+	bebop := &Anime{ID: "1", Media: Media{CanonicalTitle: "Cowboy Bebop"}}
+	want := &Character{ID: "1", Slug: "spike-spiegel", Name: "Spike Spiegel", MALID: 1, PrimaryMedia: bebop}
+	want.AnimeCharacters = []*AnimeCharacter{{ID: "3", Role: "main", Anime: bebop, Character: want}}
+	deepEqual(t, got, want, "Character.Show character mismatch")
+
+	if got.PrimaryMedia != got.AnimeCharacters[0].Anime {
+		t.Error("Character.Show returned different values for the same anime")
+	}
+}
+
+func TestCharacterService_Show_notFound(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/"+defaultAPIVersion+"characters/0", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		http.Error(w, `{"errors":[{"title":"Record not found","detail":"The record identified by 0 could not be found.","code":"404","status":"404"}]}`, http.StatusNotFound)
+	})
+
+	_, resp, err := client.Character.Show(context.Background(), "0")
+	if err == nil {
+		t.Error("Expected HTTP 404 error.")
+	}
+
+	if resp == nil {
+		t.Error("Expected to return HTTP response despite the API error.")
+	}
+}
+
+func TestCharacterService_List(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/"+defaultAPIVersion+"characters", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", defaultMediaType)
+		testFormValues(t, r, values{
+			"page[limit]":  "2",
+			"page[offset]": "0",
+		})
+
+		fmt.Fprint(w, `{
+			"data":[
+				{"id":"1","type":"characters","attributes":{"name":"Spike Spiegel"}},
+				{"id":"2","type":"characters","attributes":{"name":"Faye Valentine"}}
+			],
+			"links":{
+				"first":"https://kitsu.io/api/edge/characters?page%5Blimit%5D=2&page%5Boffset%5D=0",
+				"next":"https://kitsu.io/api/edge/characters?page%5Blimit%5D=2&page%5Boffset%5D=2",
+				"last":"https://kitsu.io/api/edge/characters?page%5Blimit%5D=2&page%5Boffset%5D=100"
+			}
+		}`)
+	})
+
+	got, resp, err := client.Character.List(context.Background(), Pagination(2, 0))
+	if err != nil {
+		t.Fatalf("Character.List returned error: %v", err)
+	}
+
+	want := []*Character{
+		{ID: "1", Name: "Spike Spiegel"},
+		{ID: "2", Name: "Faye Valentine"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Character.List mismatch\nhave: %#+v\nwant: %#+v", got, want)
+	}
+	offset := PageOffset{First: 0, Last: 100, Next: 2, Prev: 0}
+	if got, want := resp.Offset, offset; got != want {
+		t.Errorf("Character.List response Offset = %+v, want %+v", got, want)
+	}
+}
+
+func TestCharacterService_Search(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/"+defaultAPIVersion+"characters", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"filter[name]": "spike",
+			"page[limit]":  "1",
+		})
+		fmt.Fprint(w, `{"data":[{"id":"1","type":"characters","attributes":{"name":"Spike Spiegel"}}]}`)
+	})
+
+	got, _, err := client.Character.Search(context.Background(), "spike", Limit(1))
+	if err != nil {
+		t.Fatalf("Character.Search returned error: %v", err)
+	}
+
+	want := []*Character{{ID: "1", Name: "Spike Spiegel"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Character.Search mismatch\nhave: %#+v\nwant: %#+v", got, want)
+	}
+}
@@ -0,0 +1,92 @@
+package kitsu
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+const dateLayout = "2006-01-02"
+
+// Date represents a civil date without a time of day or a time zone. It is
+// used for date-only values returned by the Kitsu API such as
+// Anime.StartDate and User.Birthday, e.g. 2013-04-07.
+//
+// The zero value of Date is used when the API returns null.
+type Date struct {
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+// ParseDate parses a date in the YYYY-MM-DD format, e.g. 2013-04-07. An
+// ISO 8601 timestamp such as 2013-04-07T00:00:00.000Z is also accepted in
+// which case only its date part is kept.
+func ParseDate(s string) (Date, error) {
+	t, err := time.Parse(dateLayout, s)
+	if err != nil {
+		var terr error
+		if t, terr = time.Parse(time.RFC3339, s); terr != nil {
+			return Date{}, fmt.Errorf("cannot parse date %q: %v", s, err)
+		}
+	}
+	return DateOf(t), nil
+}
+
+// DateOf returns the Date in which t occurs in t's location.
+func DateOf(t time.Time) Date {
+	y, m, d := t.Date()
+	return Date{Year: y, Month: m, Day: d}
+}
+
+// IsZero reports whether d is the zero value, which stands for no date.
+func (d Date) IsZero() bool {
+	return d == Date{}
+}
+
+// String returns the date in the YYYY-MM-DD format or the empty string for
+// the zero Date.
+func (d Date) String() string {
+	if d.IsZero() {
+		return ""
+	}
+	return fmt.Sprintf("%04d-%02d-%02d", d.Year, d.Month, d.Day)
+}
+
+// In returns the time at midnight of d in the given location.
+func (d Date) In(loc *time.Location) time.Time {
+	return time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, loc)
+}
+
+// MarshalJSON implements the json.Marshaler interface. The zero Date is
+// encoded as null.
+func (d Date) MarshalJSON() ([]byte, error) {
+	if d.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(d.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. A JSON null or an
+// empty string result in the zero Date.
+func (d *Date) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		*d = Date{}
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("cannot unmarshal %s into Date: %v", data, err)
+	}
+	if s == "" {
+		*d = Date{}
+		return nil
+	}
+	v, err := ParseDate(s)
+	if err != nil {
+		return err
+	}
+	*d = v
+	return nil
+}
@@ -0,0 +1,84 @@
+package kitsu
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestParseDate(t *testing.T) {
+	var tests = []struct {
+		in      string
+		want    Date
+		wantErr bool
+	}{
+		{"2013-04-07", Date{2013, time.April, 7}, false},
+		{"2013-04-07T22:21:26.824Z", Date{2013, time.April, 7}, false},
+		{"2013-04-07T01:00:00+09:00", Date{2013, time.April, 7}, false},
+		{"07/04/2013", Date{}, true},
+		{"", Date{}, true},
+	}
+	for _, tt := range tests {
+		got, err := ParseDate(tt.in)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseDate(%q) err = %v, want err %v", tt.in, err, tt.wantErr)
+		}
+		if got != tt.want {
+			t.Errorf("ParseDate(%q) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestDate_UnmarshalJSON(t *testing.T) {
+	var tests = []struct {
+		in      string
+		want    Date
+		wantErr bool
+	}{
+		{`"2013-09-28"`, Date{2013, time.September, 28}, false},
+		{`null`, Date{}, false},
+		{`""`, Date{}, false},
+		{`20130928`, Date{}, true},
+		{`"tomorrow"`, Date{}, true},
+	}
+	for _, tt := range tests {
+		d := Date{1, 1, 1}
+		err := json.Unmarshal([]byte(tt.in), &d)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("Unmarshal(%s) err = %v, want err %v", tt.in, err, tt.wantErr)
+		}
+		if err == nil && d != tt.want {
+			t.Errorf("Unmarshal(%s) = %v, want %v", tt.in, d, tt.want)
+		}
+	}
+}
+
+func TestDate_MarshalJSON(t *testing.T) {
+	var tests = []struct {
+		in   Date
+		want string
+	}{
+		{Date{2013, time.April, 7}, `"2013-04-07"`},
+		{Date{}, `null`},
+	}
+	for _, tt := range tests {
+		got, err := json.Marshal(tt.in)
+		if err != nil {
+			t.Fatalf("Marshal(%#v) returned err: %v", tt.in, err)
+		}
+		if string(got) != tt.want {
+			t.Errorf("Marshal(%#v) = %s, want %s", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestDate_In(t *testing.T) {
+	d := Date{2013, time.April, 7}
+	want := time.Date(2013, time.April, 7, 0, 0, 0, 0, time.UTC)
+	if got := d.In(time.UTC); !got.Equal(want) {
+		t.Errorf("Date.In(UTC) = %v, want %v", got, want)
+	}
+	if got := DateOf(want); got != d {
+		t.Errorf("DateOf(%v) = %v, want %v", want, got, d)
+	}
+}
@@ -3,31 +3,15 @@
 package kitsu
 
 import (
-	"fmt"
-	"log"
-	"net/http"
-	"net/http/httputil"
+	"log/slog"
+	"os"
 )
 
-func dumpRequest(req *http.Request, body bool) {
-	dump, err := httputil.DumpRequest(req, body)
-	if err != nil {
-		log.Println("Request dump failed:", err)
-		return
-	}
-	fmt.Println("------------ Request dump -------------")
-	fmt.Println(string(dump))
-	fmt.Println("")
-}
+// debugLogger is the logger of clients without a Logger when built with
+// -tags=debug. It writes the requests and responses, including their bodies,
+// to stdout.
+var debugLogger = slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}))
 
-func dumpResponse(resp *http.Response, body bool) {
-	dump, err := httputil.DumpResponse(resp, body)
-	if err != nil {
-		log.Println("Response dump failed:", err)
-		return
-	}
-	fmt.Println("------------ Response dump ------------")
-	fmt.Println(string(dump))
-	fmt.Println("")
-	fmt.Println("---------------------------------------")
-}
+// debugBodies enables logging the bodies of requests and responses for all
+// clients when built with -tags=debug.
+const debugBodies = true
@@ -2,8 +2,8 @@
 
 package kitsu
 
-import "net/http"
+import "log/slog"
 
-func dumpRequest(req *http.Request, body bool) {}
+var debugLogger *slog.Logger
 
-func dumpResponse(resp *http.Response, body bool) {}
+const debugBodies = false
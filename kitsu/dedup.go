@@ -0,0 +1,102 @@
+package kitsu
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// flightGroup collapses concurrent identical GET requests into a single HTTP
+// call, similar to golang.org/x/sync/singleflight. The response body is read
+// once and each caller receives its own copy of the response so that it can
+// be decoded independently.
+type flightGroup struct {
+	mu    sync.Mutex
+	calls map[string]*flightCall
+}
+
+// testHookJoin, if set, is called by tests when a caller joins the in-flight
+// call for key.
+var testHookJoin func(key string)
+
+// flightCall is an in-flight or completed HTTP call.
+type flightCall struct {
+	done chan struct{} // Closed when the call completes.
+
+	resp *http.Response // Body already consumed, see body.
+	body []byte
+	err  error
+}
+
+// do calls fn for the first caller with key and makes the concurrent callers
+// with the same key wait for its result. Callers stop waiting if their ctx is
+// done.
+func (g *flightGroup) do(ctx context.Context, key string, fn func() (*http.Response, error)) (*http.Response, error) {
+	g.mu.Lock()
+	if g.calls == nil {
+		g.calls = make(map[string]*flightCall)
+	}
+	if call, ok := g.calls[key]; ok {
+		g.mu.Unlock()
+		if testHookJoin != nil {
+			testHookJoin(key)
+		}
+		select {
+		case <-call.done:
+			return call.response()
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	call := &flightCall{done: make(chan struct{})}
+	g.calls[key] = call
+	g.mu.Unlock()
+
+	call.resp, call.err = fn()
+	if call.err == nil {
+		call.body, call.err = io.ReadAll(call.resp.Body)
+		call.resp.Body.Close()
+	}
+
+	g.mu.Lock()
+	delete(g.calls, key)
+	g.mu.Unlock()
+	close(call.done)
+
+	return call.response()
+}
+
+// response returns a copy of the response of the completed call with its own
+// body and header.
+func (c *flightCall) response() (*http.Response, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	resp := new(http.Response)
+	*resp = *c.resp
+	resp.Header = c.resp.Header.Clone()
+	resp.Body = io.NopCloser(bytes.NewReader(c.body))
+	return resp, nil
+}
+
+// dedupSend is like cachedSend but, if deduplication is enabled, concurrent
+// GET requests for the same URL and credentials share a single call. Requests
+// are matched by their cache key so that callers with different credentials
+// never share a response.
+func (c *Client) dedupSend(ctx context.Context, req *http.Request) (*http.Response, error) {
+	if !c.DeduplicateRequests || req.Method != http.MethodGet {
+		return c.cachedSend(ctx, req)
+	}
+	resp, err := c.flights.do(ctx, c.cacheKey(req), func() (*http.Response, error) {
+		return c.cachedSend(ctx, req)
+	})
+	if err != nil && ctx.Err() == nil && (errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)) {
+		// The context of the caller that made the shared call was done
+		// while ours is still active so we make our own call.
+		return c.cachedSend(ctx, req)
+	}
+	return resp, err
+}
@@ -0,0 +1,211 @@
+package kitsu
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// hookJoins sets testHookJoin for the duration of the test and returns a
+// function that waits until n callers have joined an in-flight call for key.
+func hookJoins(t *testing.T) func(key string, n int) {
+	t.Helper()
+	joins := make(chan string, 100)
+	testHookJoin = func(key string) { joins <- key }
+	t.Cleanup(func() { testHookJoin = nil })
+	return func(key string, n int) {
+		t.Helper()
+		timeout := time.After(5 * time.Second)
+		for n > 0 {
+			select {
+			case k := <-joins:
+				if k == key {
+					n--
+				}
+			case <-timeout:
+				t.Fatalf("timed out waiting for %d more callers to join the call", n)
+			}
+		}
+	}
+}
+
+func TestClient_DeduplicateRequests(t *testing.T) {
+	setup()
+	defer teardown()
+	client.DeduplicateRequests = true
+	waitJoins := hookJoins(t)
+
+	var hits int32
+	release := make(chan struct{})
+	mux.HandleFunc("/"+defaultAPIVersion+"anime/1", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		<-release
+		fmt.Fprint(w, `{"data":{"id":"1","type":"anime","attributes":{"slug":"cowboy-bebop"},
+			"relationships":{"genres":{"data":[{"type":"genres","id":"1"}]}}}}`)
+	})
+
+	const n = 5
+	results := make([]*Anime, n)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			a, _, err := client.Anime.Show(context.Background(), "1")
+			if err != nil {
+				t.Errorf("Anime.Show returned err: %v", err)
+				return
+			}
+			results[i] = a
+		}(i)
+	}
+	waitJoins(client.BaseURL.String()+"/"+defaultAPIVersion+"anime/1", n-1)
+	close(release)
+	wg.Wait()
+
+	if got, want := atomic.LoadInt32(&hits), int32(1); got != want {
+		t.Errorf("server hits = %d, want %d", got, want)
+	}
+	for i, a := range results {
+		if a == nil {
+			continue
+		}
+		if got, want := a.Slug, "cowboy-bebop"; got != want {
+			t.Errorf("result %d slug = %q, want %q", i, got, want)
+		}
+		for j := 0; j < i; j++ {
+			if results[j] == a || results[j].Genres[0] == a.Genres[0] {
+				t.Errorf("results %d and %d share values", j, i)
+			}
+		}
+	}
+
+	// Once completed, the call is not reused.
+	if _, _, err := client.Anime.Show(context.Background(), "1"); err != nil {
+		t.Fatalf("Anime.Show returned err: %v", err)
+	}
+	if got, want := atomic.LoadInt32(&hits), int32(2); got != want {
+		t.Errorf("server hits = %d, want %d", got, want)
+	}
+}
+
+func TestClient_DeduplicateRequests_canceledLeader(t *testing.T) {
+	setup()
+	defer teardown()
+	client.DeduplicateRequests = true
+	waitJoins := hookJoins(t)
+
+	var hits int32
+	release := make(chan struct{})
+	mux.HandleFunc("/"+defaultAPIVersion+"anime/1", func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&hits, 1) == 1 {
+			<-release
+		}
+		fmt.Fprint(w, `{"data":{"id":"1","type":"anime"}}`)
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	leaderDone := make(chan error)
+	go func() {
+		_, _, err := client.Anime.Show(ctx, "1")
+		leaderDone <- err
+	}()
+	// Wait for the leader's call to reach the server.
+	for atomic.LoadInt32(&hits) == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	followerDone := make(chan error)
+	go func() {
+		_, _, err := client.Anime.Show(context.Background(), "1")
+		followerDone <- err
+	}()
+	waitJoins(client.BaseURL.String()+"/"+defaultAPIVersion+"anime/1", 1)
+
+	cancel()
+	if err := <-leaderDone; err == nil {
+		t.Error("Anime.Show with canceled context expected to return err")
+	}
+	if err := <-followerDone; err != nil {
+		t.Errorf("Anime.Show of follower returned err: %v", err)
+	}
+	close(release)
+}
+
+func TestClient_DeduplicateRequests_scope(t *testing.T) {
+	setup()
+	defer teardown()
+	client.DeduplicateRequests = true
+
+	// The handler only responds once both requests have reached the server,
+	// which never happens if they are collapsed into a single call.
+	var arrived sync.WaitGroup
+	arrived.Add(2)
+	both := make(chan struct{})
+	go func() {
+		arrived.Wait()
+		close(both)
+	}()
+	mux.HandleFunc("/"+defaultAPIVersion+"users/1", func(w http.ResponseWriter, r *http.Request) {
+		arrived.Done()
+		select {
+		case <-both:
+		case <-time.After(5 * time.Second):
+			t.Error("timed out waiting for both requests to reach the server")
+		}
+		fmt.Fprintf(w, `{"data":{"id":"1","type":"users","attributes":{"name":%q}}}`, r.Header.Get("Authorization"))
+	})
+
+	var wg sync.WaitGroup
+	for _, auth := range []string{"alice", "bob"} {
+		wg.Add(1)
+		go func(auth string) {
+			defer wg.Done()
+			req, err := client.NewRequest("GET", defaultAPIVersion+"users/1", nil)
+			if err != nil {
+				t.Errorf("NewRequest returned err: %v", err)
+				return
+			}
+			req.Header.Set("Authorization", auth)
+			u := new(User)
+			if _, err := client.Do(context.Background(), req, u); err != nil {
+				t.Errorf("Do with Authorization %q returned err: %v", auth, err)
+				return
+			}
+			if got, want := u.Name, auth; got != want {
+				t.Errorf("Do with Authorization %q got user %q, want %q", auth, got, want)
+			}
+		}(auth)
+	}
+	wg.Wait()
+}
+
+func TestClient_DeduplicateRequests_disabled(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var hits int32
+	mux.HandleFunc("/"+defaultAPIVersion+"anime/1", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		fmt.Fprint(w, `{"data":{"id":"1","type":"anime"}}`)
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, _, err := client.Anime.Show(context.Background(), "1"); err != nil {
+				t.Errorf("Anime.Show returned err: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+	if got, want := atomic.LoadInt32(&hits), int32(3); got != want {
+		t.Errorf("server hits = %d, want %d", got, want)
+	}
+}
@@ -0,0 +1,77 @@
+package kitsu
+
+import (
+	"context"
+	"fmt"
+	"iter"
+)
+
+// DramaService handles communication with the drama related methods of the
+// Kitsu API.
+//
+// Kitsu API docs:
+// http://docs.kitsu.apiary.io/#reference/media/drama
+type DramaService service
+
+// Drama represents a Kitsu drama.
+//
+// Additional filters: text
+type Drama struct {
+	ID string `jsonapi:"primary,dramas"`
+
+	// Media holds the attributes and relationships common to all media
+	// types.
+	Media
+
+	// --- Attributes ---
+
+	// How many episodes the drama has, e.g. 25
+	EpisodeCount int `jsonapi:"attr,episodeCount,omitempty"`
+
+	// How many minutes long each episode is, e.g. 60
+	EpisodeLength int `jsonapi:"attr,episodeLength,omitempty"`
+}
+
+// Show returns details for a specific Drama by providing a unique identifier
+// of the drama e.g. 1.
+func (s *DramaService) Show(ctx context.Context, dramaID string, opts ...URLOption) (*Drama, *Response, error) {
+	u := fmt.Sprintf(defaultAPIVersion+"dramas/%s", dramaID)
+
+	req, err := s.client.NewRequest("GET", u, nil, opts...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	d := new(Drama)
+	resp, err := s.client.Do(ctx, req, d)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return d, resp, nil
+}
+
+// List returns a list of Drama. Optional parameters can be specified to filter
+// the search results and control pagination, sorting etc.
+func (s *DramaService) List(ctx context.Context, opts ...URLOption) ([]*Drama, *Response, error) {
+	u := defaultAPIVersion + "dramas"
+
+	req, err := s.client.NewRequest("GET", u, nil, opts...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var dramas []*Drama
+	resp, err := s.client.Do(ctx, req, &dramas)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return dramas, resp, nil
+}
+
+// All returns an iterator over all the Drama that match opts; see
+// AnimeService.All.
+func (s *DramaService) All(ctx context.Context, opts ...URLOption) iter.Seq2[*Drama, error] {
+	return all(ctx, s.List, opts)
+}
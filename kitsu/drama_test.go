@@ -0,0 +1,126 @@
+package kitsu
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestDramaService_Show(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/"+defaultAPIVersion+"dramas/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", defaultMediaType)
+		testFormValues(t, r, values{
+			"include": "genres",
+		})
+		fmt.Fprint(w, `{
+			"data":{
+				"id":"1",
+				"type":"dramas",
+				"attributes":{
+					"slug":"boys-over-flowers",
+					"canonicalTitle":"Boys Over Flowers",
+					"titles":{"en":"Boys Over Flowers","ko_kr":"꽃보다 남자"},
+					"ageRating":"PG",
+					"startDate":"2009-01-05",
+					"episodeCount":25
+				},
+				"relationships":{
+					"genres":{
+						"data":[{"type":"genres","id":"4"}]
+					}
+				}
+			},
+			"included":[
+				{"id":"4","type":"genres","attributes":{"name":"Romance","slug":"romance"}}
+			]
+		}`)
+	})
+
+	got, _, err := client.Drama.Show(context.Background(), "1", Include("genres"))
+	if err != nil {
+		t.Fatalf("Drama.Show returned error: %v", err)
+	}
+
+	want := &Drama{
+		ID: "1",
+		Media: Media{
+			Slug:           "boys-over-flowers",
+			CanonicalTitle: "Boys Over Flowers",
+			Titles:         map[string]string{"en": "Boys Over Flowers", "ko_kr": "꽃보다 남자"},
+			AgeRating:      AgeRatingPG,
+			StartDate:      Date{Year: 2009, Month: 1, Day: 5},
+			Genres:         []*Genre{{ID: "4", Name: "Romance", Slug: "romance"}},
+		},
+		EpisodeCount: 25,
+	}
+	deepEqual(t, got, want, "Drama.Show drama mismatch")
+}
+
+func TestDramaService_Show_notFound(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/"+defaultAPIVersion+"dramas/0", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		http.Error(w, `{"errors":[{"title":"Record not found","detail":"The record identified by 0 could not be found.","code":"404","status":"404"}]}`, http.StatusNotFound)
+	})
+
+	_, resp, err := client.Drama.Show(context.Background(), "0")
+	if err == nil {
+		t.Error("Expected HTTP 404 error.")
+	}
+
+	if resp == nil {
+		t.Error("Expected to return HTTP response despite the API error.")
+	}
+}
+
+func TestDramaService_List(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/"+defaultAPIVersion+"dramas", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", defaultMediaType)
+		testFormValues(t, r, values{
+			"page[limit]":  "2",
+			"page[offset]": "0",
+			"filter[text]": "flowers",
+		})
+
+		fmt.Fprint(w, `{
+			"data":[
+				{"id":"1","type":"dramas","attributes":{"slug":"boys-over-flowers"}},
+				{"id":"2","type":"dramas","attributes":{"slug":"flower-boy-ramen-shop"}}
+			],
+			"links":{
+				"first":"https://kitsu.io/api/edge/dramas?page%5Blimit%5D=2&page%5Boffset%5D=0",
+				"next":"https://kitsu.io/api/edge/dramas?page%5Blimit%5D=2&page%5Boffset%5D=2",
+				"last":"https://kitsu.io/api/edge/dramas?page%5Blimit%5D=2&page%5Boffset%5D=4"
+			}
+		}`)
+	})
+
+	got, resp, err := client.Drama.List(context.Background(), Pagination(2, 0), Search("flowers"))
+	if err != nil {
+		t.Fatalf("Drama.List returned error: %v", err)
+	}
+
+	want := []*Drama{
+		{ID: "1", Media: Media{Slug: "boys-over-flowers"}},
+		{ID: "2", Media: Media{Slug: "flower-boy-ramen-shop"}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Drama.List mismatch\nhave: %#+v\nwant: %#+v", got, want)
+	}
+	offset := PageOffset{First: 0, Last: 4, Next: 2, Prev: 0}
+	if got, want := resp.Offset, offset; got != want {
+		t.Errorf("Drama.List response Offset = %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,225 @@
+package kitsu
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/nstratos/go-kitsu/kitsu/internal/jsonapi"
+)
+
+// Errors that classify the API errors. They can be checked with errors.Is:
+//
+//	_, _, err := c.Anime.Show(ctx, "0")
+//	if errors.Is(err, kitsu.ErrNotFound) {
+//		// Handle missing anime.
+//	}
+//
+// The details of the error remain available through errors.As with an
+// *ErrorResponse.
+var (
+	// ErrNotFound is matched by errors with status 404 Not Found.
+	ErrNotFound = errors.New("kitsu: not found")
+
+	// ErrUnauthorized is matched by errors with status 401 Unauthorized or
+	// 403 Forbidden, e.g. when the token is missing, has expired or does
+	// not grant access to the resource.
+	ErrUnauthorized = errors.New("kitsu: unauthorized")
+
+	// ErrRateLimited is matched by errors with status 429 Too Many Requests.
+	ErrRateLimited = errors.New("kitsu: rate limited")
+
+	// ErrValidation is matched by errors with status 422 Unprocessable
+	// Entity which the API returns when the attributes of a created or
+	// updated resource are invalid. See ValidationError.
+	ErrValidation = errors.New("kitsu: validation failed")
+)
+
+// ErrorResponse reports one or more errors caused by an API request.
+type ErrorResponse struct {
+	Response *http.Response // HTTP response that caused this error
+	Errors   []Error        `json:"errors"`
+}
+
+func (r *ErrorResponse) Error() string {
+	return fmt.Sprintf("%v %v: %d %+v",
+		r.Response.Request.Method, r.Response.Request.URL,
+		r.Response.StatusCode, r.Errors)
+}
+
+// Is reports whether the status code of the response matches target which
+// should be one of ErrNotFound, ErrUnauthorized, ErrRateLimited or
+// ErrValidation.
+func (r *ErrorResponse) Is(target error) bool {
+	if r.Response == nil {
+		return false
+	}
+	switch c := r.Response.StatusCode; target {
+	case ErrNotFound:
+		return c == http.StatusNotFound
+	case ErrUnauthorized:
+		return c == http.StatusUnauthorized || c == http.StatusForbidden
+	case ErrRateLimited:
+		return c == http.StatusTooManyRequests
+	case ErrValidation:
+		return c == http.StatusUnprocessableEntity
+	}
+	return false
+}
+
+// Error holds the details of each individual error in an ErrorResponse.
+//
+// JSON API docs: http://jsonapi.org/format/#error-objects
+type Error struct {
+	Title  string `json:"title"`
+	Detail string `json:"detail"`
+	Code   string `json:"code"`
+	Status string `json:"status"`
+
+	// Source references the part of the request that caused the error. It
+	// is nil if the API did not return it.
+	Source *ErrorSource `json:"source,omitempty"`
+
+	// Meta holds non-standard information about the error.
+	Meta map[string]interface{} `json:"meta,omitempty"`
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%v: error %v: %v(%v)",
+		e.Status, e.Code, e.Title, e.Detail)
+}
+
+// ErrorSource references the source of an Error.
+type ErrorSource struct {
+	// Pointer is a JSON Pointer to the member of the request document that
+	// caused the error, e.g. /data/attributes/progress.
+	Pointer string `json:"pointer,omitempty"`
+
+	// Parameter is the URL query parameter that caused the error, e.g.
+	// filter[unknown].
+	Parameter string `json:"parameter,omitempty"`
+}
+
+// ValidationError is returned instead of an ErrorResponse when the API
+// rejects the attributes or relationships of a resource. It maps each error
+// to the struct field of the resource that caused it:
+//
+//	_, _, err := c.Library.Update(ctx, e, []string{"progress"})
+//	var verr *kitsu.ValidationError
+//	if errors.As(err, &verr) {
+//		for _, f := range verr.Fields {
+//			fmt.Println(f.Field, f.Detail) // e.g. Progress must be greater than or equal to 0
+//		}
+//	}
+//
+// It matches ErrValidation and wraps the ErrorResponse so errors.As can
+// retrieve either of them. Note that a type assertion of the error to
+// *ErrorResponse does not match a ValidationError; use errors.As instead.
+type ValidationError struct {
+	*ErrorResponse
+	Fields []FieldError
+}
+
+// FieldError is a single error of a ValidationError.
+type FieldError struct {
+	// Field is the name of the struct field of the resource, e.g. Progress
+	// for LibraryEntry. It is empty if the error does not refer to a known
+	// attribute or relationship.
+	Field string
+
+	// Member is the name of the attribute or relationship as it appears in
+	// the JSON API document, e.g. progress.
+	Member string
+
+	// Detail is the description of the error, e.g. must be greater than or
+	// equal to 0.
+	Detail string
+
+	// Err is the original error of the ErrorResponse.
+	Err *Error
+}
+
+func (e *ValidationError) Error() string {
+	msgs := make([]string, len(e.Fields))
+	for i, f := range e.Fields {
+		name := f.Field
+		if name == "" {
+			name = f.Member
+		}
+		if name == "" {
+			msgs[i] = f.Detail
+			continue
+		}
+		msgs[i] = name + ": " + f.Detail
+	}
+	return fmt.Sprintf("%v %v: %d validation failed: %s",
+		e.Response.Request.Method, e.Response.Request.URL,
+		e.Response.StatusCode, strings.Join(msgs, "; "))
+}
+
+// Unwrap returns the ErrorResponse.
+func (e *ValidationError) Unwrap() error {
+	return e.ErrorResponse
+}
+
+// newValidationError maps the errors of r to the fields of v, the value that
+// the request decodes to, e.g. *LibraryEntry. If v is nil, only the members
+// are set.
+func newValidationError(r *ErrorResponse, v interface{}) *ValidationError {
+	verr := &ValidationError{ErrorResponse: r}
+	for i := range r.Errors {
+		e := &r.Errors[i]
+		f := FieldError{Detail: e.Title, Err: e}
+		if f.Detail == "" {
+			f.Detail = e.Detail
+		}
+		if e.Source != nil {
+			f.Member = pointerMember(e.Source.Pointer)
+		}
+		if f.Member != "" && v != nil {
+			f.Field, _ = jsonapi.FieldName(v, f.Member)
+		}
+		verr.Fields = append(verr.Fields, f)
+	}
+	return verr
+}
+
+// pointerMember returns the member name that a JSON Pointer like
+// /data/attributes/progress or /data/relationships/user refers to or the
+// empty string for other pointers.
+func pointerMember(pointer string) string {
+	for _, prefix := range []string{"/data/attributes/", "/data/relationships/"} {
+		if m, ok := strings.CutPrefix(pointer, prefix); ok && m != "" && !strings.Contains(m, "/") {
+			return m
+		}
+	}
+	return ""
+}
+
+// checkResponse checks the API response for errors and returns them if
+// present. A response is considered an error if it has a status code outside
+// the 200 range.
+//
+// API error responses are expected to have either no response body, or a JSON
+// response body that maps to ErrorResponse. Any other response body will be
+// silently ignored.
+//
+// Validation errors are returned as a ValidationError whose fields are mapped
+// to the struct fields of v, the value that the response would be decoded to.
+func checkResponse(r *http.Response, v interface{}) error {
+	if c := r.StatusCode; 200 <= c && c <= 299 {
+		return nil
+	}
+	errorResponse := &ErrorResponse{Response: r}
+	body, err := io.ReadAll(r.Body)
+	if err == nil && body != nil {
+		_ = json.Unmarshal(body, errorResponse)
+	}
+	if errorResponse.Is(ErrValidation) {
+		return newValidationError(errorResponse, v)
+	}
+	return errorResponse
+}
@@ -0,0 +1,164 @@
+package kitsu
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestErrorResponse_Error(t *testing.T) {
+	resp := &http.Response{Request: &http.Request{}}
+	err := ErrorResponse{Response: resp}
+	if err.Error() == "" {
+		t.Errorf("Expected non-empty ErrorResponse.Error()")
+	}
+}
+
+func TestError_Error(t *testing.T) {
+	err := Error{}
+	if err.Error() == "" {
+		t.Errorf("Expected non-empty Error.Error()")
+	}
+}
+
+func TestErrorResponse_Is(t *testing.T) {
+	sentinels := []error{ErrNotFound, ErrUnauthorized, ErrRateLimited, ErrValidation}
+	tests := []struct {
+		status int
+		want   error
+	}{
+		{http.StatusNotFound, ErrNotFound},
+		{http.StatusUnauthorized, ErrUnauthorized},
+		{http.StatusForbidden, ErrUnauthorized},
+		{http.StatusTooManyRequests, ErrRateLimited},
+		{http.StatusUnprocessableEntity, ErrValidation},
+		{http.StatusBadRequest, nil},
+		{http.StatusInternalServerError, nil},
+	}
+	for _, tt := range tests {
+		t.Run(http.StatusText(tt.status), func(t *testing.T) {
+			setup()
+			defer teardown()
+
+			mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+				http.Error(w, fmt.Sprintf(`{"errors":[{"status":"%d"}]}`, tt.status), tt.status)
+			})
+
+			req, _ := client.NewRequest("GET", "/", nil)
+			_, err := client.Do(context.Background(), req, nil)
+			if err == nil {
+				t.Fatal("Do expected to return err")
+			}
+			for _, target := range sentinels {
+				if got, want := errors.Is(err, target), target == tt.want; got != want {
+					t.Errorf("errors.Is(err, %v) = %v, want %v", target, got, want)
+				}
+			}
+			var errResp *ErrorResponse
+			if !errors.As(err, &errResp) {
+				t.Fatal("errors.As(err, *ErrorResponse) = false, want true")
+			}
+			if got, want := errResp.Response.StatusCode, tt.status; got != want {
+				t.Errorf("ErrorResponse status = %d, want %d", got, want)
+			}
+		})
+	}
+}
+
+func TestErrorResponse_source(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"errors":[{
+			"title":"Filter not allowed",
+			"detail":"unknown_attribute is not allowed.",
+			"code":"102",
+			"status":"400",
+			"source":{"parameter":"filter[unknown_attribute]"},
+			"meta":{"allowed":["text"]}
+		}]}`, http.StatusBadRequest)
+	})
+
+	req, _ := client.NewRequest("GET", "/", nil)
+	_, err := client.Do(context.Background(), req, nil)
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) {
+		t.Fatalf("Do returned err %v, want *ErrorResponse", err)
+	}
+	want := []Error{{
+		Title:  "Filter not allowed",
+		Detail: "unknown_attribute is not allowed.",
+		Code:   "102",
+		Status: "400",
+		Source: &ErrorSource{Parameter: "filter[unknown_attribute]"},
+		Meta:   map[string]interface{}{"allowed": []interface{}{"text"}},
+	}}
+	if got := errResp.Errors; !reflect.DeepEqual(got, want) {
+		t.Errorf("ErrorResponse.Errors\nhave: %#v\nwant: %#v", got, want)
+	}
+}
+
+func TestValidationError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/"+defaultAPIVersion+"library-entries/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		w.Header().Set("Content-Type", defaultMediaType)
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		fmt.Fprint(w, `{"errors":[
+			{"title":"must be greater than or equal to 0","detail":"progress - must be greater than or equal to 0","code":"100","status":"422","source":{"pointer":"/data/attributes/progress"}},
+			{"title":"must exist","detail":"user - must exist","code":"100","status":"422","source":{"pointer":"/data/relationships/user"}},
+			{"title":"is unknown","status":"422","source":{"pointer":"/data/attributes/unknown"}},
+			{"title":"is invalid","status":"422","source":{"pointer":"/data"}}
+		]}`)
+	})
+
+	e := &LibraryEntry{ID: "1", Progress: -1}
+	_, _, err := client.Library.Update(context.Background(), e, []string{"progress"})
+	if !errors.Is(err, ErrValidation) {
+		t.Fatalf("Library.Update returned err %v, want ErrValidation", err)
+	}
+
+	var verr *ValidationError
+	if !errors.As(err, &verr) {
+		t.Fatalf("errors.As(err, *ValidationError) = false, want true")
+	}
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) || errResp != verr.ErrorResponse {
+		t.Errorf("errors.As(err, *ErrorResponse) did not return the wrapped ErrorResponse")
+	}
+
+	want := []FieldError{
+		{Field: "Progress", Member: "progress", Detail: "must be greater than or equal to 0", Err: &verr.Errors[0]},
+		{Field: "User", Member: "user", Detail: "must exist", Err: &verr.Errors[1]},
+		{Member: "unknown", Detail: "is unknown", Err: &verr.Errors[2]},
+		{Detail: "is invalid", Err: &verr.Errors[3]},
+	}
+	if got := verr.Fields; !reflect.DeepEqual(got, want) {
+		t.Errorf("ValidationError.Fields\nhave: %#v\nwant: %#v", got, want)
+	}
+	if verr.Error() == "" {
+		t.Errorf("Expected non-empty ValidationError.Error()")
+	}
+}
+
+func Test_pointerMember(t *testing.T) {
+	tests := []struct{ in, want string }{
+		{"/data/attributes/progress", "progress"},
+		{"/data/relationships/user", "user"},
+		{"/data/attributes/titles/en", ""},
+		{"/data/attributes/", ""},
+		{"/data", ""},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		if got := pointerMember(tt.in); got != tt.want {
+			t.Errorf("pointerMember(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,397 @@
+// Code generated by genfields; DO NOT EDIT.
+
+package kitsu
+
+// AnimeField is an attribute or relationship name of
+// Anime. See AnimeFields.
+type AnimeField string
+
+// The attributes and relationships of Anime.
+const (
+	AnimeFieldCreatedAt           AnimeField = "createdAt"
+	AnimeFieldUpdatedAt           AnimeField = "updatedAt"
+	AnimeFieldSlug                AnimeField = "slug"
+	AnimeFieldSynopsis            AnimeField = "synopsis"
+	AnimeFieldCoverImageTopOffset AnimeField = "coverImageTopOffset"
+	AnimeFieldTitles              AnimeField = "titles"
+	AnimeFieldCanonicalTitle      AnimeField = "canonicalTitle"
+	AnimeFieldAbbreviatedTitles   AnimeField = "abbreviatedTitles"
+	AnimeFieldAverageRating       AnimeField = "averageRating"
+	AnimeFieldRatingFrequencies   AnimeField = "ratingFrequencies"
+	AnimeFieldUserCount           AnimeField = "userCount"
+	AnimeFieldFavoritesCount      AnimeField = "favoritesCount"
+	AnimeFieldStartDate           AnimeField = "startDate"
+	AnimeFieldEndDate             AnimeField = "endDate"
+	AnimeFieldPopularityRank      AnimeField = "popularityRank"
+	AnimeFieldRatingRank          AnimeField = "ratingRank"
+	AnimeFieldAgeRating           AnimeField = "ageRating"
+	AnimeFieldAgeRatingGuide      AnimeField = "ageRatingGuide"
+	AnimeFieldPosterImage         AnimeField = "posterImage"
+	AnimeFieldCoverImage          AnimeField = "coverImage"
+	AnimeFieldGenres              AnimeField = "genres"
+	AnimeFieldMappings            AnimeField = "mappings"
+	AnimeFieldSubtype             AnimeField = "subtype"
+	AnimeFieldStatus              AnimeField = "status"
+	AnimeFieldEpisodeCount        AnimeField = "episodeCount"
+	AnimeFieldEpisodeLength       AnimeField = "episodeLength"
+	AnimeFieldYoutubeVideoID      AnimeField = "youtubeVideoId"
+	AnimeFieldStaff               AnimeField = "animeStaff"
+	AnimeFieldCharacters          AnimeField = "animeCharacters"
+	AnimeFieldProductions         AnimeField = "animeProductions"
+	AnimeFieldCastings            AnimeField = "castings"
+)
+
+// AnimeFields is like Fields for the "anime" resource type.
+func AnimeFields(fields ...AnimeField) URLOption {
+	return Fields("anime", fieldNames(fields)...)
+}
+
+// AnimeCharacterField is an attribute or relationship name of
+// AnimeCharacter. See AnimeCharacterFields.
+type AnimeCharacterField string
+
+// The attributes and relationships of AnimeCharacter.
+const (
+	AnimeCharacterFieldRole      AnimeCharacterField = "role"
+	AnimeCharacterFieldAnime     AnimeCharacterField = "anime"
+	AnimeCharacterFieldCharacter AnimeCharacterField = "character"
+)
+
+// AnimeCharacterFields is like Fields for the "animeCharacters" resource type.
+func AnimeCharacterFields(fields ...AnimeCharacterField) URLOption {
+	return Fields("animeCharacters", fieldNames(fields)...)
+}
+
+// AnimeProductionField is an attribute or relationship name of
+// AnimeProduction. See AnimeProductionFields.
+type AnimeProductionField string
+
+// The attributes and relationships of AnimeProduction.
+const (
+	AnimeProductionFieldCreatedAt AnimeProductionField = "createdAt"
+	AnimeProductionFieldUpdatedAt AnimeProductionField = "updatedAt"
+	AnimeProductionFieldRole      AnimeProductionField = "role"
+	AnimeProductionFieldAnime     AnimeProductionField = "anime"
+	AnimeProductionFieldProducer  AnimeProductionField = "producer"
+)
+
+// AnimeProductionFields is like Fields for the "animeProductions" resource type.
+func AnimeProductionFields(fields ...AnimeProductionField) URLOption {
+	return Fields("animeProductions", fieldNames(fields)...)
+}
+
+// AnimeStaffField is an attribute or relationship name of
+// AnimeStaff. See AnimeStaffFields.
+type AnimeStaffField string
+
+// The attributes and relationships of AnimeStaff.
+const (
+	AnimeStaffFieldRole      AnimeStaffField = "role"
+	AnimeStaffFieldCreatedAt AnimeStaffField = "createdAt"
+	AnimeStaffFieldUpdatedAt AnimeStaffField = "updatedAt"
+	AnimeStaffFieldAnime     AnimeStaffField = "anime"
+	AnimeStaffFieldPerson    AnimeStaffField = "person"
+)
+
+// AnimeStaffFields is like Fields for the "animeStaff" resource type.
+func AnimeStaffFields(fields ...AnimeStaffField) URLOption {
+	return Fields("animeStaff", fieldNames(fields)...)
+}
+
+// CastingField is an attribute or relationship name of
+// Casting. See CastingFields.
+type CastingField string
+
+// The attributes and relationships of Casting.
+const (
+	CastingFieldRole       CastingField = "role"
+	CastingFieldVoiceActor CastingField = "voiceActor"
+	CastingFieldFeatured   CastingField = "featured"
+	CastingFieldLanguage   CastingField = "language"
+	CastingFieldCharacter  CastingField = "character"
+	CastingFieldPerson     CastingField = "person"
+)
+
+// CastingFields is like Fields for the "castings" resource type.
+func CastingFields(fields ...CastingField) URLOption {
+	return Fields("castings", fieldNames(fields)...)
+}
+
+// CharacterField is an attribute or relationship name of
+// Character. See CharacterFields.
+type CharacterField string
+
+// The attributes and relationships of Character.
+const (
+	CharacterFieldSlug            CharacterField = "slug"
+	CharacterFieldName            CharacterField = "name"
+	CharacterFieldMALID           CharacterField = "malId"
+	CharacterFieldDescription     CharacterField = "description"
+	CharacterFieldImage           CharacterField = "image"
+	CharacterFieldPrimaryMedia    CharacterField = "primaryMedia"
+	CharacterFieldAnimeCharacters CharacterField = "animeCharacters"
+	CharacterFieldMangaCharacters CharacterField = "mangaCharacters"
+	CharacterFieldCastings        CharacterField = "castings"
+)
+
+// CharacterFields is like Fields for the "characters" resource type.
+func CharacterFields(fields ...CharacterField) URLOption {
+	return Fields("characters", fieldNames(fields)...)
+}
+
+// DramaField is an attribute or relationship name of
+// Drama. See DramaFields.
+type DramaField string
+
+// The attributes and relationships of Drama.
+const (
+	DramaFieldCreatedAt           DramaField = "createdAt"
+	DramaFieldUpdatedAt           DramaField = "updatedAt"
+	DramaFieldSlug                DramaField = "slug"
+	DramaFieldSynopsis            DramaField = "synopsis"
+	DramaFieldCoverImageTopOffset DramaField = "coverImageTopOffset"
+	DramaFieldTitles              DramaField = "titles"
+	DramaFieldCanonicalTitle      DramaField = "canonicalTitle"
+	DramaFieldAbbreviatedTitles   DramaField = "abbreviatedTitles"
+	DramaFieldAverageRating       DramaField = "averageRating"
+	DramaFieldRatingFrequencies   DramaField = "ratingFrequencies"
+	DramaFieldUserCount           DramaField = "userCount"
+	DramaFieldFavoritesCount      DramaField = "favoritesCount"
+	DramaFieldStartDate           DramaField = "startDate"
+	DramaFieldEndDate             DramaField = "endDate"
+	DramaFieldPopularityRank      DramaField = "popularityRank"
+	DramaFieldRatingRank          DramaField = "ratingRank"
+	DramaFieldAgeRating           DramaField = "ageRating"
+	DramaFieldAgeRatingGuide      DramaField = "ageRatingGuide"
+	DramaFieldPosterImage         DramaField = "posterImage"
+	DramaFieldCoverImage          DramaField = "coverImage"
+	DramaFieldGenres              DramaField = "genres"
+	DramaFieldMappings            DramaField = "mappings"
+	DramaFieldEpisodeCount        DramaField = "episodeCount"
+	DramaFieldEpisodeLength       DramaField = "episodeLength"
+)
+
+// DramaFields is like Fields for the "dramas" resource type.
+func DramaFields(fields ...DramaField) URLOption {
+	return Fields("dramas", fieldNames(fields)...)
+}
+
+// GenreField is an attribute or relationship name of
+// Genre. See GenreFields.
+type GenreField string
+
+// The attributes and relationships of Genre.
+const (
+	GenreFieldName        GenreField = "name"
+	GenreFieldSlug        GenreField = "slug"
+	GenreFieldDescription GenreField = "description"
+	GenreFieldCreatedAt   GenreField = "createdAt"
+	GenreFieldUpdatedAt   GenreField = "updatedAt"
+)
+
+// GenreFields is like Fields for the "genres" resource type.
+func GenreFields(fields ...GenreField) URLOption {
+	return Fields("genres", fieldNames(fields)...)
+}
+
+// LibraryEntryField is an attribute or relationship name of
+// LibraryEntry. See LibraryEntryFields.
+type LibraryEntryField string
+
+// The attributes and relationships of LibraryEntry.
+const (
+	LibraryEntryFieldStatus         LibraryEntryField = "status"
+	LibraryEntryFieldProgress       LibraryEntryField = "progress"
+	LibraryEntryFieldReconsuming    LibraryEntryField = "reconsuming"
+	LibraryEntryFieldReconsumeCount LibraryEntryField = "reconsumeCount"
+	LibraryEntryFieldNotes          LibraryEntryField = "notes"
+	LibraryEntryFieldPrivate        LibraryEntryField = "private"
+	LibraryEntryFieldRating         LibraryEntryField = "rating"
+	LibraryEntryFieldUpdatedAt      LibraryEntryField = "updatedAt"
+	LibraryEntryFieldUser           LibraryEntryField = "user"
+	LibraryEntryFieldAnime          LibraryEntryField = "anime"
+	LibraryEntryFieldManga          LibraryEntryField = "manga"
+	LibraryEntryFieldDrama          LibraryEntryField = "drama"
+	LibraryEntryFieldMedia          LibraryEntryField = "media"
+)
+
+// LibraryEntryFields is like Fields for the "libraryEntries" resource type.
+func LibraryEntryFields(fields ...LibraryEntryField) URLOption {
+	return Fields("libraryEntries", fieldNames(fields)...)
+}
+
+// MangaField is an attribute or relationship name of
+// Manga. See MangaFields.
+type MangaField string
+
+// The attributes and relationships of Manga.
+const (
+	MangaFieldCreatedAt           MangaField = "createdAt"
+	MangaFieldUpdatedAt           MangaField = "updatedAt"
+	MangaFieldSlug                MangaField = "slug"
+	MangaFieldSynopsis            MangaField = "synopsis"
+	MangaFieldCoverImageTopOffset MangaField = "coverImageTopOffset"
+	MangaFieldTitles              MangaField = "titles"
+	MangaFieldCanonicalTitle      MangaField = "canonicalTitle"
+	MangaFieldAbbreviatedTitles   MangaField = "abbreviatedTitles"
+	MangaFieldAverageRating       MangaField = "averageRating"
+	MangaFieldRatingFrequencies   MangaField = "ratingFrequencies"
+	MangaFieldUserCount           MangaField = "userCount"
+	MangaFieldFavoritesCount      MangaField = "favoritesCount"
+	MangaFieldStartDate           MangaField = "startDate"
+	MangaFieldEndDate             MangaField = "endDate"
+	MangaFieldPopularityRank      MangaField = "popularityRank"
+	MangaFieldRatingRank          MangaField = "ratingRank"
+	MangaFieldAgeRating           MangaField = "ageRating"
+	MangaFieldAgeRatingGuide      MangaField = "ageRatingGuide"
+	MangaFieldPosterImage         MangaField = "posterImage"
+	MangaFieldCoverImage          MangaField = "coverImage"
+	MangaFieldGenres              MangaField = "genres"
+	MangaFieldMappings            MangaField = "mappings"
+	MangaFieldSubtype             MangaField = "subtype"
+	MangaFieldStatus              MangaField = "status"
+	MangaFieldChapterCount        MangaField = "chapterCount"
+	MangaFieldVolumeCount         MangaField = "volumeCount"
+	MangaFieldSerialization       MangaField = "serialization"
+	MangaFieldMangaType           MangaField = "mangaType"
+	MangaFieldStaff               MangaField = "mangaStaff"
+	MangaFieldCharacters          MangaField = "mangaCharacters"
+)
+
+// MangaFields is like Fields for the "manga" resource type.
+func MangaFields(fields ...MangaField) URLOption {
+	return Fields("manga", fieldNames(fields)...)
+}
+
+// MangaCharacterField is an attribute or relationship name of
+// MangaCharacter. See MangaCharacterFields.
+type MangaCharacterField string
+
+// The attributes and relationships of MangaCharacter.
+const (
+	MangaCharacterFieldRole      MangaCharacterField = "role"
+	MangaCharacterFieldManga     MangaCharacterField = "manga"
+	MangaCharacterFieldCharacter MangaCharacterField = "character"
+)
+
+// MangaCharacterFields is like Fields for the "mangaCharacters" resource type.
+func MangaCharacterFields(fields ...MangaCharacterField) URLOption {
+	return Fields("mangaCharacters", fieldNames(fields)...)
+}
+
+// MangaStaffField is an attribute or relationship name of
+// MangaStaff. See MangaStaffFields.
+type MangaStaffField string
+
+// The attributes and relationships of MangaStaff.
+const (
+	MangaStaffFieldRole      MangaStaffField = "role"
+	MangaStaffFieldCreatedAt MangaStaffField = "createdAt"
+	MangaStaffFieldUpdatedAt MangaStaffField = "updatedAt"
+	MangaStaffFieldManga     MangaStaffField = "manga"
+	MangaStaffFieldPerson    MangaStaffField = "person"
+)
+
+// MangaStaffFields is like Fields for the "mangaStaff" resource type.
+func MangaStaffFields(fields ...MangaStaffField) URLOption {
+	return Fields("mangaStaff", fieldNames(fields)...)
+}
+
+// MappingField is an attribute or relationship name of
+// Mapping. See MappingFields.
+type MappingField string
+
+// The attributes and relationships of Mapping.
+const (
+	MappingFieldCreatedAt    MappingField = "createdAt"
+	MappingFieldUpdatedAt    MappingField = "updatedAt"
+	MappingFieldExternalSite MappingField = "externalSite"
+	MappingFieldExternalID   MappingField = "externalId"
+)
+
+// MappingFields is like Fields for the "mappings" resource type.
+func MappingFields(fields ...MappingField) URLOption {
+	return Fields("mappings", fieldNames(fields)...)
+}
+
+// PersonField is an attribute or relationship name of
+// Person. See PersonFields.
+type PersonField string
+
+// The attributes and relationships of Person.
+const (
+	PersonFieldName        PersonField = "name"
+	PersonFieldMALID       PersonField = "malId"
+	PersonFieldDescription PersonField = "description"
+	PersonFieldImage       PersonField = "image"
+	PersonFieldAnimeStaff  PersonField = "animeStaff"
+	PersonFieldMangaStaff  PersonField = "mangaStaff"
+	PersonFieldCastings    PersonField = "castings"
+)
+
+// PersonFields is like Fields for the "people" resource type.
+func PersonFields(fields ...PersonField) URLOption {
+	return Fields("people", fieldNames(fields)...)
+}
+
+// ProducerField is an attribute or relationship name of
+// Producer. See ProducerFields.
+type ProducerField string
+
+// The attributes and relationships of Producer.
+const (
+	ProducerFieldSlug             ProducerField = "slug"
+	ProducerFieldName             ProducerField = "name"
+	ProducerFieldCreatedAt        ProducerField = "createdAt"
+	ProducerFieldUpdatedAt        ProducerField = "updatedAt"
+	ProducerFieldAnimeProductions ProducerField = "animeProductions"
+)
+
+// ProducerFields is like Fields for the "producers" resource type.
+func ProducerFields(fields ...ProducerField) URLOption {
+	return Fields("producers", fieldNames(fields)...)
+}
+
+// UserField is an attribute or relationship name of
+// User. See UserFields.
+type UserField string
+
+// The attributes and relationships of User.
+const (
+	UserFieldCreatedAt           UserField = "createdAt"
+	UserFieldUpdatedAt           UserField = "updatedAt"
+	UserFieldName                UserField = "name"
+	UserFieldPastNames           UserField = "pastNames"
+	UserFieldSlug                UserField = "slug"
+	UserFieldAbout               UserField = "about"
+	UserFieldLocation            UserField = "location"
+	UserFieldWaifuOrHusbando     UserField = "waifuOrHusbando"
+	UserFieldFollowersCount      UserField = "followersCount"
+	UserFieldFollowingCount      UserField = "followingCount"
+	UserFieldBirthday            UserField = "birthday"
+	UserFieldGender              UserField = "gender"
+	UserFieldCommentsCount       UserField = "commentsCount"
+	UserFieldFavoritesCount      UserField = "favoritesCount"
+	UserFieldLikesGivenCount     UserField = "likesGivenCount"
+	UserFieldReviewsCount        UserField = "reviewsCount"
+	UserFieldLikesReceivedCount  UserField = "likesReceivedCount"
+	UserFieldPostsCount          UserField = "postsCount"
+	UserFieldRatingsCount        UserField = "ratingsCount"
+	UserFieldMediaReactionsCount UserField = "mediaReactionsCount"
+	UserFieldProExpiresAt        UserField = "proExpiresAt"
+	UserFieldTitle               UserField = "title"
+	UserFieldProfileCompleted    UserField = "profileCompleted"
+	UserFieldFeedCompleted       UserField = "feedCompleted"
+	UserFieldAvatar              UserField = "avatar"
+	UserFieldCoverImage          UserField = "coverImage"
+	UserFieldRatingSystem        UserField = "ratingSystem"
+	UserFieldTheme               UserField = "theme"
+	UserFieldFacebookID          UserField = "facebookId"
+	UserFieldWaifu               UserField = "waifu"
+	UserFieldLibraryEntries      UserField = "libraryEntries"
+)
+
+// UserFields is like Fields for the "users" resource type.
+func UserFields(fields ...UserField) URLOption {
+	return Fields("users", fieldNames(fields)...)
+}
@@ -0,0 +1,29 @@
+package kitsu
+
+// Image holds the URLs of the different sizes of an image such as
+// Anime.PosterImage, Character.Image or User.Avatar. Sizes that are not
+// available for a certain image are left empty.
+type Image struct {
+	Tiny     string `json:"tiny,omitempty"`
+	Small    string `json:"small,omitempty"`
+	Medium   string `json:"medium,omitempty"`
+	Large    string `json:"large,omitempty"`
+	Original string `json:"original,omitempty"`
+
+	// Meta holds additional information about the image like the dimensions
+	// of each size. It is nil if the API did not return it.
+	Meta *ImageMeta `json:"meta,omitempty"`
+}
+
+// ImageMeta holds additional information about an Image.
+type ImageMeta struct {
+	// Dimensions of each size of the image, keyed by size name, e.g. "tiny".
+	Dimensions map[string]ImageDimensions `json:"dimensions,omitempty"`
+}
+
+// ImageDimensions holds the width and height of an Image size in pixels. They
+// are zero if unknown.
+type ImageDimensions struct {
+	Width  int `json:"width"`
+	Height int `json:"height"`
+}
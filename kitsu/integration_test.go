@@ -8,7 +8,7 @@ import (
 	"testing"
 
 	"github.com/nstratos/go-kitsu/kitsu"
-	"golang.org/x/oauth2"
+	"github.com/nstratos/go-kitsu/kitsu/auth"
 )
 
 var (
@@ -33,24 +33,15 @@ func setup(t *testing.T) *kitsu.Client {
 		t.Skip("Skipping integration tests.")
 	}
 
-	conf := &oauth2.Config{
-		Endpoint: oauth2.Endpoint{
-			TokenURL: "https://kitsu.io/api/oauth/token",
-		},
-	}
-
 	ctx := context.Background()
-	tok, err := conf.PasswordCredentialsToken(ctx, *testAccountSlug, *testAccountPassword)
+	kitsuClient, err := auth.NewClient(ctx, nil, *testAccountSlug, *testAccountPassword)
 	if err != nil {
-		t.Fatal("could not get token:", err)
+		t.Fatal("could not authenticate:", err)
 	}
 
-	httpClient := conf.Client(ctx, tok)
-	kitsuClient := kitsu.NewClient(httpClient)
-
 	var once sync.Once
 	once.Do(func() {
-		users, _, err := kitsuClient.User.List(kitsu.Filter("slug", *testAccountSlug))
+		users, _, err := kitsuClient.User.List(ctx, kitsu.Filter("slug", *testAccountSlug))
 		if err != nil {
 			t.Fatal("searching users by slug failed:", err)
 		}
@@ -65,10 +56,12 @@ func setup(t *testing.T) *kitsu.Client {
 
 func TestAnimeServiceIntegration(t *testing.T) {
 	c := setup(t)
+	ctx := context.Background()
 	const results = 5
 
 	// Get anime list with options to include specific limit and includes.
 	list, resp, err := c.Anime.List(
+		ctx,
 		kitsu.Limit(results),
 		kitsu.Include("animeCharacters.character", "animeStaff.person"),
 	)
@@ -117,6 +110,7 @@ func TestAnimeServiceIntegration(t *testing.T) {
 	// includes both characters and staff.
 	const bebopMovieID = "2"
 	bebop, _, err := c.Anime.Show(
+		ctx,
 		bebopMovieID,
 		kitsu.Include("animeCharacters.character", "animeStaff.person"),
 	)
@@ -140,9 +134,11 @@ func TestAnimeServiceIntegration(t *testing.T) {
 
 func TestLibraryServiceIntegration(t *testing.T) {
 	c := setup(t)
+	ctx := context.Background()
 
 	// Get all library entries for test account.
 	entries, _, err := c.Library.List(
+		ctx,
 		kitsu.Filter("userId", testAccountID),
 	)
 	if err != nil {
@@ -167,20 +163,21 @@ func TestLibraryServiceIntegration(t *testing.T) {
 		},
 	}
 
-	e, _, err := c.Library.Create(newEntry)
+	e, _, err := c.Library.Create(ctx, newEntry)
 	if err != nil {
 		t.Fatal("could not create library:", err)
 	}
 
 	// Clean up at the end.
 	defer func() {
-		if _, derr := c.Library.Delete(e.ID); derr != nil {
+		if _, derr := c.Library.Delete(ctx, e.ID); derr != nil {
 			t.Errorf("deleting entry with ID %q returned err: %v", e.ID, derr)
 		}
 	}()
 
 	// Get all library entries again.
 	entries, _, err = c.Library.List(
+		ctx,
 		kitsu.Filter("userId", testAccountID),
 	)
 	if err != nil {
@@ -0,0 +1,157 @@
+// Command genfields generates the typed sparse fieldset helpers of package
+// kitsu from the jsonapi struct tags of its resources.
+//
+// For each struct type with a primary jsonapi struct tag, e.g. Anime, it
+// generates a named string type AnimeField, a constant for each attribute and
+// relationship, e.g. AnimeFieldCanonicalTitle, and an AnimeFields URLOption
+// that wraps Fields.
+//
+// It is meant to be run with go generate from the directory of package kitsu.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+func main() {
+	var (
+		dir    = flag.String("dir", ".", "directory of the package to parse")
+		output = flag.String("output", "fields_gen.go", "output file name")
+	)
+	flag.Parse()
+	log.SetFlags(0)
+	log.SetPrefix("genfields: ")
+
+	src, err := generate(*dir, *output)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*output, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// resource is a struct type with a primary jsonapi struct tag.
+type resource struct {
+	goName string
+	typ    string // Resource type, e.g. anime.
+	fields []field
+}
+
+// field is an attribute or relationship of a resource.
+type field struct {
+	goName string
+	name   string
+}
+
+func generate(dir, output string) ([]byte, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	fset := token.NewFileSet()
+	var files []*ast.File
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") || name == output {
+			continue
+		}
+		f, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, 0)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, f)
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no Go files in %s", dir)
+	}
+	pkgName := files[0].Name.Name
+
+	structs := make(map[string]*ast.StructType)
+	for _, f := range files {
+		ast.Inspect(f, func(n ast.Node) bool {
+			if ts, ok := n.(*ast.TypeSpec); ok {
+				if st, ok := ts.Type.(*ast.StructType); ok && ts.Name.IsExported() {
+					structs[ts.Name.Name] = st
+				}
+			}
+			return true
+		})
+	}
+
+	var resources []resource
+	for name, st := range structs {
+		r := resource{goName: name}
+		if err := collect(&r, st, structs); err != nil {
+			return nil, fmt.Errorf("%s: %v", name, err)
+		}
+		if r.typ != "" {
+			resources = append(resources, r)
+		}
+	}
+	sort.Slice(resources, func(i, j int) bool { return resources[i].goName < resources[j].goName })
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by genfields; DO NOT EDIT.\n\npackage %s\n", pkgName)
+	for _, r := range resources {
+		fieldType := r.goName + "Field"
+		fmt.Fprintf(&buf, "\n// %s is an attribute or relationship name of\n// %s. See %sFields.\ntype %s string\n", fieldType, r.goName, r.goName, fieldType)
+		fmt.Fprintf(&buf, "\n// The attributes and relationships of %s.\nconst (\n", r.goName)
+		for _, f := range r.fields {
+			fmt.Fprintf(&buf, "\t%s%s %s = %s\n", fieldType, f.goName, fieldType, strconv.Quote(f.name))
+		}
+		fmt.Fprint(&buf, ")\n")
+		fmt.Fprintf(&buf, "\n// %sFields is like Fields for the %s resource type.\nfunc %sFields(fields ...%s) URLOption {\n\treturn Fields(%s, fieldNames(fields)...)\n}\n",
+			r.goName, strconv.Quote(r.typ), r.goName, fieldType, strconv.Quote(r.typ))
+	}
+	return format.Source(buf.Bytes())
+}
+
+// collect adds the jsonapi tagged fields of st to r, including the fields of
+// embedded structs without a tag.
+func collect(r *resource, st *ast.StructType, structs map[string]*ast.StructType) error {
+	for _, f := range st.Fields.List {
+		var tag string
+		if f.Tag != nil {
+			s, err := strconv.Unquote(f.Tag.Value)
+			if err != nil {
+				return err
+			}
+			tag = reflect.StructTag(s).Get("jsonapi")
+		}
+		if tag == "" {
+			if len(f.Names) == 0 {
+				if ident, ok := f.Type.(*ast.Ident); ok && structs[ident.Name] != nil {
+					if err := collect(r, structs[ident.Name], structs); err != nil {
+						return err
+					}
+				}
+			}
+			continue
+		}
+		args := strings.Split(tag, ",")
+		if len(args) < 2 || len(f.Names) != 1 {
+			return fmt.Errorf("bad jsonapi struct tag %q", tag)
+		}
+		switch args[0] {
+		case "primary":
+			r.typ = args[1]
+		case "attr", "relation":
+			r.fields = append(r.fields, field{goName: f.Names[0].Name, name: args[1]})
+		}
+	}
+	return nil
+}
@@ -0,0 +1,25 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// The generated file of package kitsu must be regenerated each time a
+// resource changes.
+func TestGenerate_upToDate(t *testing.T) {
+	const dir, output = "../../..", "fields_gen.go"
+	want, err := os.ReadFile(filepath.Join(dir, output))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := generate(dir, output)
+	if err != nil {
+		t.Fatalf("generate returned err: %v", err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("%s is out of date, run go generate in package kitsu", output)
+	}
+}
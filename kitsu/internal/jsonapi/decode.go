@@ -0,0 +1,278 @@
+package jsonapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// document is the top-level object of a JSON API document as it is received.
+type document struct {
+	Data     json.RawMessage            `json:"data"`
+	Included []*resource                `json:"included"`
+	Links    *Links                     `json:"links"`
+	Meta     map[string]json.RawMessage `json:"meta"`
+}
+
+// resource is a JSON API resource object. Its attributes are kept undecoded
+// so that they can later be decoded straight into the fields of the target
+// struct using the encoding/json semantics. That way any type that implements
+// json.Unmarshaler, like time.Time, can be used as an attribute.
+type resource struct {
+	Type          string                      `json:"type"`
+	ID            string                      `json:"id"`
+	Attributes    map[string]json.RawMessage  `json:"attributes"`
+	Relationships map[string]relationshipNode `json:"relationships"`
+}
+
+// relationshipNode is a JSON API relationship object. Data holds the resource
+// linkage which can be null, a single resource identifier or an array of them.
+type relationshipNode struct {
+	Data json.RawMessage `json:"data"`
+}
+
+// identifier is a JSON API resource identifier object.
+type identifier struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+}
+
+func (i identifier) key() string { return i.Type + "," + i.ID }
+
+// decoder decodes the resources of a single document. It resolves resource
+// linkages against the included resources of the document.
+type decoder struct {
+	included []*resource
+	byKey    map[string]*resource
+
+	// resolved is the identity map of the document. It holds the value of
+	// each resource that has been decoded so far so that every reference to
+	// the same resource shares a single pointer. Resources are added before
+	// their fields are decoded which also takes care of cycles between
+	// resources that refer to each other.
+	resolved map[resolvedKey]reflect.Value
+
+	// attached holds the keys of the included resources that have been
+	// attached to a relationship.
+	attached map[string]bool
+}
+
+// resolvedKey identifies a resource decoded to a certain Go type.
+type resolvedKey struct {
+	id  identifier
+	typ reflect.Type
+}
+
+func newDecoder(doc *document) *decoder {
+	d := &decoder{
+		included: doc.Included,
+		byKey:    make(map[string]*resource, len(doc.Included)),
+		resolved: make(map[resolvedKey]reflect.Value),
+		attached: make(map[string]bool),
+	}
+	for _, res := range doc.Included {
+		d.byKey[identifier{res.Type, res.ID}.key()] = res
+	}
+	return d
+}
+
+var errNoData = errors.New("document has no primary data")
+
+// decodeData decodes the primary data of doc into val which must be a struct
+// or a slice of pointers to structs.
+func (d *decoder) decodeData(data json.RawMessage, val reflect.Value) error {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 {
+		return errNoData
+	}
+	isArray := data[0] == '['
+
+	switch val.Kind() {
+	case reflect.Struct:
+		if isArray {
+			return fmt.Errorf("cannot decode array of resources to %v", val.Type())
+		}
+		if bytes.Equal(data, []byte("null")) {
+			return nil
+		}
+		res := new(resource)
+		if err := json.Unmarshal(data, res); err != nil {
+			return err
+		}
+		d.resolved[resolvedKey{identifier{res.Type, res.ID}, val.Addr().Type()}] = val.Addr()
+		return d.decodeFields(res, val)
+	case reflect.Slice:
+		if !isArray {
+			return fmt.Errorf("cannot decode single resource to %v", val.Type())
+		}
+		elemType := val.Type().Elem()
+		if elemType.Kind() != reflect.Ptr || elemType.Elem().Kind() != reflect.Struct {
+			return fmt.Errorf("cannot decode to %v, need slice of pointers to structs", val.Type())
+		}
+		var resources []*resource
+		if err := json.Unmarshal(data, &resources); err != nil {
+			return err
+		}
+		// All the primary resources are added to the identity map before any
+		// of them is decoded so that included resources can refer to them.
+		elems := make([]reflect.Value, len(resources))
+		for i, res := range resources {
+			elems[i] = reflect.New(elemType.Elem())
+			d.resolved[resolvedKey{identifier{res.Type, res.ID}, elemType}] = elems[i]
+		}
+		for i, res := range resources {
+			if err := d.decodeFields(res, elems[i].Elem()); err != nil {
+				return err
+			}
+			val.Set(reflect.Append(val, elems[i]))
+		}
+		return nil
+	}
+	return fmt.Errorf("cannot decode to %v", val.Type())
+}
+
+// decodeFields stores res in val which must be a settable struct value.
+func (d *decoder) decodeFields(res *resource, val reflect.Value) error {
+	fields, err := typeFields(val.Type())
+	if err != nil {
+		return err
+	}
+	for _, f := range fields {
+		fv := val.FieldByIndex(f.index)
+		switch f.annotation {
+		case annotationPrimary:
+			if res.Type != f.name {
+				return fmt.Errorf("cannot decode resource of type %q to %v with type %q", res.Type, val.Type(), f.name)
+			}
+			fv.SetString(res.ID)
+		case annotationAttribute:
+			raw, ok := res.Attributes[f.name]
+			if !ok {
+				continue
+			}
+			if err := json.Unmarshal(raw, fv.Addr().Interface()); err != nil {
+				return fmt.Errorf("cannot decode attribute %q of %q resource: %v", f.name, res.Type, err)
+			}
+		case annotationRelation:
+			rel, ok := res.Relationships[f.name]
+			if !ok {
+				continue
+			}
+			if err := d.decodeRelationship(rel, fv); err != nil {
+				return fmt.Errorf("cannot decode relationship %q of %q resource: %v", f.name, res.Type, err)
+			}
+		}
+	}
+	return nil
+}
+
+// decodeRelationship stores the resources that rel links to in fv which can
+// be a pointer to struct, a slice of pointers to structs or an interface that
+// is satisfied by a type passed to Register. Relationships that
+// have no resource linkage, for example because they only contain links, are
+// skipped.
+func (d *decoder) decodeRelationship(rel relationshipNode, fv reflect.Value) error {
+	data := bytes.TrimSpace(rel.Data)
+	if len(data) == 0 || bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	switch {
+	case fv.Kind() == reflect.Slice && fv.Type().Elem().Kind() == reflect.Ptr:
+		var ids []identifier
+		if err := json.Unmarshal(data, &ids); err != nil {
+			return err
+		}
+		s := reflect.MakeSlice(fv.Type(), 0, len(ids))
+		for _, id := range ids {
+			v, err := d.resolve(id, fv.Type().Elem())
+			if err != nil {
+				return err
+			}
+			s = reflect.Append(s, v)
+		}
+		fv.Set(s)
+		return nil
+	case fv.Kind() == reflect.Ptr && fv.Type().Elem().Kind() == reflect.Struct:
+		var id identifier
+		if err := json.Unmarshal(data, &id); err != nil {
+			return err
+		}
+		v, err := d.resolve(id, fv.Type())
+		if err != nil {
+			return err
+		}
+		fv.Set(v)
+		return nil
+	case fv.Kind() == reflect.Interface:
+		var id identifier
+		if err := json.Unmarshal(data, &id); err != nil {
+			return err
+		}
+		// Resources of unregistered types or of types that do not satisfy the
+		// interface are skipped so that new resource types introduced by the
+		// API do not break decoding.
+		typ := registeredType(id.Type)
+		if typ == nil || !typ.Implements(fv.Type()) {
+			return nil
+		}
+		v, err := d.resolve(id, typ)
+		if err != nil {
+			return err
+		}
+		fv.Set(v)
+		return nil
+	}
+	return fmt.Errorf("unsupported relationship field type %v", fv.Type())
+}
+
+// resolve returns the value of typ, which must be a pointer to struct, that
+// holds the resource identified by id. If the resource is part of the
+// included resources, it is fully decoded. Otherwise only its ID is set. The
+// same value is returned each time the resource is resolved to typ.
+func (d *decoder) resolve(id identifier, typ reflect.Type) (reflect.Value, error) {
+	key := resolvedKey{id, typ}
+	if v, ok := d.resolved[key]; ok {
+		return v, nil
+	}
+	res, ok := d.byKey[id.key()]
+	if ok {
+		d.attached[id.key()] = true
+	} else {
+		res = &resource{Type: id.Type, ID: id.ID}
+	}
+	v := reflect.New(typ.Elem())
+	d.resolved[key] = v
+	if err := d.decodeFields(res, v.Elem()); err != nil {
+		return reflect.Value{}, err
+	}
+	return v, nil
+}
+
+// unattached decodes the included resources that were not attached to any
+// relationship while decoding the primary data. They are returned in the
+// order they appear in the document, as pointers to the types passed to
+// Register. Resources of unregistered types are skipped.
+func (d *decoder) unattached() ([]interface{}, error) {
+	var pending []*resource
+	for _, res := range d.included {
+		if !d.attached[identifier{res.Type, res.ID}.key()] {
+			pending = append(pending, res)
+		}
+	}
+	var values []interface{}
+	for _, res := range pending {
+		typ := registeredType(res.Type)
+		if typ == nil {
+			continue
+		}
+		v, err := d.resolve(identifier{res.Type, res.ID}, typ)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, v.Interface())
+	}
+	return values, nil
+}
@@ -0,0 +1,178 @@
+package jsonapi
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+type Character struct {
+	ID    string  `jsonapi:"primary,characters"`
+	Name  string  `jsonapi:"attr,name"`
+	Voice *Person `jsonapi:"relation,voice"`
+}
+
+type Person struct {
+	ID         string       `jsonapi:"primary,people"`
+	Name       string       `jsonapi:"attr,name"`
+	Characters []*Character `jsonapi:"relation,characters"`
+}
+
+type Show struct {
+	ID         string                `jsonapi:"primary,shows"`
+	CreatedAt  time.Time             `jsonapi:"attr,createdAt"`
+	EndedAt    *time.Time            `jsonapi:"attr,endedAt"`
+	Image      struct{ Tiny string } `jsonapi:"attr,image"`
+	Titles     map[string]string     `jsonapi:"attr,titles"`
+	Characters []*Character          `jsonapi:"relation,characters"`
+	Media      interface{}           `jsonapi:"relation,media"`
+}
+
+func TestDecode_attributes(t *testing.T) {
+	in := `{"data":{"type":"shows","id":"1","attributes":{
+		"createdAt":"2017-07-27T22:21:26.824Z",
+		"endedAt":null,
+		"image":{"Tiny":"tiny.jpg"},
+		"titles":{"en":"Cowboy Bebop"},
+		"unknown":1
+	}}}`
+
+	got := new(Show)
+	if _, err := Decode(strings.NewReader(in), got); err != nil {
+		t.Fatalf("Decode returned err: %v", err)
+	}
+
+	want := &Show{
+		ID:        "1",
+		CreatedAt: time.Date(2017, 7, 27, 22, 21, 26, 824e6, time.UTC),
+		Titles:    map[string]string{"en": "Cowboy Bebop"},
+	}
+	want.Image.Tiny = "tiny.jpg"
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Decode \nhave: %#v\nwant: %#v", got, want)
+	}
+}
+
+func TestDecode_badAttribute(t *testing.T) {
+	in := `{"data":{"type":"shows","id":"1","attributes":{"createdAt":"yesterday"}}}`
+	if _, err := Decode(strings.NewReader(in), new(Show)); err == nil {
+		t.Error("Decode with bad time attribute expected to return err")
+	}
+}
+
+func TestDecode_relationships(t *testing.T) {
+	in := `{
+		"data":{"type":"shows","id":"1","relationships":{
+			"characters":{"data":[{"type":"characters","id":"2"},{"type":"characters","id":"3"}]},
+			"media":{"data":{"type":"anime","id":"1"}}
+		}},
+		"included":[
+			{"type":"characters","id":"2","attributes":{"name":"Spike"},"relationships":{
+				"voice":{"data":{"type":"people","id":"4"}}
+			}},
+			{"type":"people","id":"4","attributes":{"name":"Kouichi Yamadera"},"relationships":{
+				"characters":{"data":[{"type":"characters","id":"2"}]}
+			}}
+		]
+	}`
+
+	got := new(Show)
+	if _, err := Decode(strings.NewReader(in), got); err != nil {
+		t.Fatalf("Decode returned err: %v", err)
+	}
+
+	// Character 3 is not included so only its ID is known. Character 2 and
+	// person 4 refer to each other.
+	spike := &Character{ID: "2", Name: "Spike"}
+	spike.Voice = &Person{ID: "4", Name: "Kouichi Yamadera", Characters: []*Character{spike}}
+	want := &Show{
+		ID:         "1",
+		Characters: []*Character{spike, {ID: "3"}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Decode \nhave: %#v\nwant: %#v", got, want)
+	}
+	if got.Characters[0] != got.Characters[0].Voice.Characters[0] {
+		t.Error("Decode created separate values for the same character")
+	}
+}
+
+func TestDecode_identityMap(t *testing.T) {
+	in := `{
+		"data":[
+			{"type":"characters","id":"1","relationships":{"voice":{"data":{"type":"people","id":"3"}}}},
+			{"type":"characters","id":"2","relationships":{"voice":{"data":{"type":"people","id":"3"}}}},
+			{"type":"characters","id":"4","relationships":{"voice":{"data":{"type":"people","id":"5"}}}},
+			{"type":"characters","id":"6","relationships":{"voice":{"data":{"type":"people","id":"5"}}}}
+		],
+		"included":[
+			{"type":"people","id":"3","attributes":{"name":"Kouichi Yamadera"},"relationships":{
+				"characters":{"data":[{"type":"characters","id":"1"},{"type":"characters","id":"2"}]}
+			}}
+		]
+	}`
+
+	var got []*Character
+	if _, err := Decode(strings.NewReader(in), &got); err != nil {
+		t.Fatalf("Decode returned err: %v", err)
+	}
+
+	// Both included and not included resources are shared and included
+	// resources refer back to the primary data.
+	if got[0].Voice != got[1].Voice {
+		t.Error("Decode created separate values for included person 3")
+	}
+	if got[2].Voice != got[3].Voice {
+		t.Error("Decode created separate values for not included person 5")
+	}
+	if chars := got[0].Voice.Characters; chars[0] != got[0] || chars[1] != got[1] {
+		t.Error("Decode created separate values for the characters of person 3")
+	}
+}
+
+func TestDecode_relationshipWithoutData(t *testing.T) {
+	in := `{"data":{"type":"shows","id":"1","relationships":{
+		"characters":{"links":{"related":"https://kitsu.io/api/edge/shows/1/characters"}}
+	}}}`
+
+	got := new(Show)
+	if _, err := Decode(strings.NewReader(in), got); err != nil {
+		t.Fatalf("Decode returned err: %v", err)
+	}
+	if got.Characters != nil {
+		t.Errorf("Decode relationship without data = %#v, want nil", got.Characters)
+	}
+}
+
+func TestDecode_relationshipBadType(t *testing.T) {
+	in := `{"data":{"type":"shows","id":"1","relationships":{
+		"characters":{"data":[{"type":"people","id":"2"}]}
+	}}}`
+
+	if _, err := Decode(strings.NewReader(in), new(Show)); err == nil {
+		t.Error("Decode with relationship of wrong type expected to return err")
+	}
+}
+
+type Base struct {
+	CreatedAt time.Time `jsonapi:"attr,createdAt"`
+}
+
+type Embedding struct {
+	Base
+	ID string `jsonapi:"primary,embeddings"`
+}
+
+func TestDecode_embeddedStruct(t *testing.T) {
+	in := `{"data":{"type":"embeddings","id":"1","attributes":{"createdAt":"2017-07-27T22:21:26Z"}}}`
+
+	got := new(Embedding)
+	if _, err := Decode(strings.NewReader(in), got); err != nil {
+		t.Fatalf("Decode returned err: %v", err)
+	}
+	want := &Embedding{ID: "1", Base: Base{CreatedAt: time.Date(2017, 7, 27, 22, 21, 26, 0, time.UTC)}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Decode \nhave: %#v\nwant: %#v", got, want)
+	}
+}
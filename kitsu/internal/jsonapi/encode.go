@@ -0,0 +1,172 @@
+package jsonapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+)
+
+// node is a JSON API resource object as it is sent.
+type node struct {
+	Type          string                 `json:"type"`
+	ID            string                 `json:"id,omitempty"`
+	Attributes    map[string]interface{} `json:"attributes,omitempty"`
+	Relationships map[string]interface{} `json:"relationships,omitempty"`
+}
+
+type onePayload struct {
+	Data *node `json:"data"`
+}
+
+type manyPayload struct {
+	Data []*node `json:"data"`
+}
+
+// toOneRelationship is a relationship object with to-one resource linkage. A
+// nil Data is encoded as null which empties the relationship.
+type toOneRelationship struct {
+	Data *identifier `json:"data"`
+}
+
+// toManyRelationship is a relationship object with to-many resource linkage.
+type toManyRelationship struct {
+	Data []identifier `json:"data"`
+}
+
+// encodePayload writes the document with v as its primary data. It requires
+// v to be a pointer to struct or a slice of pointers to structs.
+func encodePayload(w io.Writer, v reflect.Value) error {
+	var payload interface{}
+	switch v.Kind() {
+	case reflect.Ptr:
+		n, err := encodeResource(v.Elem(), nil)
+		if err != nil {
+			return err
+		}
+		payload = onePayload{Data: n}
+	case reflect.Slice:
+		nodes := make([]*node, 0, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			elem := v.Index(i)
+			if elem.IsNil() {
+				return fmt.Errorf("cannot encode nil element %d of %v", i, v.Type())
+			}
+			n, err := encodeResource(elem.Elem(), nil)
+			if err != nil {
+				return err
+			}
+			nodes = append(nodes, n)
+		}
+		payload = manyPayload{Data: nodes}
+	}
+	return json.NewEncoder(w).Encode(payload)
+}
+
+// encodeResource returns the resource object of struct val.
+//
+// If only is nil, all the attributes and relationships are encoded apart
+// from those that are tagged with omitempty and hold zero values. Otherwise
+// only the attributes whose names are in only are encoded, regardless of
+// their values, and an unknown name results in an error.
+func encodeResource(val reflect.Value, only map[string]bool) (*node, error) {
+	fields, err := typeFields(val.Type())
+	if err != nil {
+		return nil, err
+	}
+	n := new(node)
+	for _, f := range fields {
+		fv := val.FieldByIndex(f.index)
+		switch f.annotation {
+		case annotationPrimary:
+			n.Type = f.name
+			n.ID = fv.String()
+		case annotationAttribute:
+			if only != nil {
+				if !only[f.name] {
+					continue
+				}
+			} else if f.omitEmpty && fv.IsZero() {
+				continue
+			}
+			if n.Attributes == nil {
+				n.Attributes = make(map[string]interface{})
+			}
+			n.Attributes[f.name] = fv.Interface()
+		case annotationRelation:
+			if only != nil {
+				continue
+			}
+			rel, err := encodeRelationship(fv, f.omitEmpty)
+			if err != nil {
+				return nil, fmt.Errorf("cannot encode relationship %q of %v: %v", f.name, val.Type(), err)
+			}
+			if rel == nil {
+				continue
+			}
+			if n.Relationships == nil {
+				n.Relationships = make(map[string]interface{})
+			}
+			n.Relationships[f.name] = rel
+		}
+	}
+	if n.Type == "" {
+		return nil, fmt.Errorf("%v has no primary jsonapi struct tag", val.Type())
+	}
+	for name := range only {
+		if _, ok := n.Attributes[name]; !ok {
+			return nil, fmt.Errorf("%v has no attribute %q", val.Type(), name)
+		}
+	}
+	return n, nil
+}
+
+// encodeRelationship returns the relationship object for fv which can be a
+// pointer to struct, a slice of pointers to structs or an interface holding a
+// pointer to struct. It returns nil if the relationship should be omitted.
+func encodeRelationship(fv reflect.Value, omitEmpty bool) (interface{}, error) {
+	switch fv.Kind() {
+	case reflect.Slice:
+		if fv.Len() == 0 && omitEmpty {
+			return nil, nil
+		}
+		ids := make([]identifier, 0, fv.Len())
+		for i := 0; i < fv.Len(); i++ {
+			id, err := identify(fv.Index(i))
+			if err != nil {
+				return nil, err
+			}
+			ids = append(ids, *id)
+		}
+		return toManyRelationship{Data: ids}, nil
+	case reflect.Ptr, reflect.Interface:
+		if fv.IsNil() {
+			if omitEmpty {
+				return nil, nil
+			}
+			return toOneRelationship{}, nil
+		}
+		id, err := identify(fv)
+		if err != nil {
+			return nil, err
+		}
+		return toOneRelationship{Data: id}, nil
+	}
+	return nil, fmt.Errorf("unsupported relationship field type %v", fv.Type())
+}
+
+// identify returns the resource identifier of v which must be a non-nil
+// pointer to struct, or an interface holding one.
+func identify(v reflect.Value) (*identifier, error) {
+	if v.Kind() == reflect.Interface {
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return nil, fmt.Errorf("cannot identify resource of type %v, need non-nil pointer to struct", v.Type())
+	}
+	f, err := primaryField(v.Elem().Type())
+	if err != nil {
+		return nil, err
+	}
+	return &identifier{Type: f.name, ID: v.Elem().FieldByIndex(f.index).String()}, nil
+}
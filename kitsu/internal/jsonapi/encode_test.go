@@ -0,0 +1,63 @@
+package jsonapi
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+func TestEncode_relationships(t *testing.T) {
+	in := &Show{
+		ID:         "1",
+		CreatedAt:  time.Date(2017, 7, 27, 22, 21, 26, 0, time.UTC),
+		Characters: []*Character{{ID: "2"}, {ID: "3"}},
+		Media:      &Anime{ID: "4"},
+	}
+	out := `{"data":{"type":"shows","id":"1","attributes":{` +
+		`"createdAt":"2017-07-27T22:21:26Z","endedAt":null,"image":{"Tiny":""},"titles":null},` +
+		`"relationships":{` +
+		`"characters":{"data":[{"type":"characters","id":"2"},{"type":"characters","id":"3"}]},` +
+		`"media":{"data":{"type":"anime","id":"4"}}}}}` + "\n"
+
+	buf := &bytes.Buffer{}
+	if err := Encode(buf, in); err != nil {
+		t.Fatalf("Encode returned err: %v", err)
+	}
+	if got, want := buf.String(), out; got != want {
+		t.Errorf("Encode \nhave: %q\nwant: %q", got, want)
+	}
+}
+
+func TestEncode_nilRelationship(t *testing.T) {
+	in := &Character{ID: "1", Name: "Spike"}
+	out := `{"data":{"type":"characters","id":"1","attributes":{"name":"Spike"},` +
+		`"relationships":{"voice":{"data":null}}}}` + "\n"
+
+	buf := &bytes.Buffer{}
+	if err := Encode(buf, in); err != nil {
+		t.Fatalf("Encode returned err: %v", err)
+	}
+	if got, want := buf.String(), out; got != want {
+		t.Errorf("Encode \nhave: %q\nwant: %q", got, want)
+	}
+}
+
+func TestEncode_badRelationship(t *testing.T) {
+	in := &Show{ID: "1", Media: "not a resource"}
+	if err := Encode(&bytes.Buffer{}, in); err == nil {
+		t.Error("Encode with relationship to non resource expected to return err")
+	}
+}
+
+func TestEncode_embeddedStruct(t *testing.T) {
+	in := &Embedding{ID: "1", Base: Base{CreatedAt: time.Date(2017, 7, 27, 22, 21, 26, 0, time.UTC)}}
+	out := `{"data":{"type":"embeddings","id":"1","attributes":{"createdAt":"2017-07-27T22:21:26Z"}}}` + "\n"
+
+	buf := &bytes.Buffer{}
+	if err := Encode(buf, in); err != nil {
+		t.Fatalf("Encode returned err: %v", err)
+	}
+	if got, want := buf.String(), out; got != want {
+		t.Errorf("Encode \nhave: %q\nwant: %q", got, want)
+	}
+}
@@ -0,0 +1,133 @@
+package jsonapi
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// The annotations of the jsonapi struct tags.
+const (
+	annotationPrimary   = "primary"
+	annotationAttribute = "attr"
+	annotationRelation  = "relation"
+	annotationOmitEmpty = "omitempty"
+)
+
+// field describes a struct field that has a jsonapi struct tag, e.g.
+//
+//	Slug string `jsonapi:"attr,slug,omitempty"`
+type field struct {
+	index      []int  // Index sequence for reflect.Value.FieldByIndex.
+	goName     string // Name of the Go struct field.
+	annotation string // One of primary, attr or relation.
+	name       string // Resource type for primary, member name otherwise.
+	omitEmpty  bool
+	typ        reflect.Type
+}
+
+var fieldCache sync.Map // map[reflect.Type][]field
+
+// typeFields returns the fields of struct type t that have a jsonapi struct
+// tag. Fields of embedded structs without a tag are promoted as if they
+// belonged to t. The result is cached.
+func typeFields(t reflect.Type) ([]field, error) {
+	if f, ok := fieldCache.Load(t); ok {
+		return f.([]field), nil
+	}
+	fields, err := collectFields(t, nil)
+	if err != nil {
+		return nil, err
+	}
+	f, _ := fieldCache.LoadOrStore(t, fields)
+	return f.([]field), nil
+}
+
+func collectFields(t reflect.Type, index []int) ([]field, error) {
+	var fields []field
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		idx := append(index[:len(index):len(index)], i)
+		tag, ok := sf.Tag.Lookup("jsonapi")
+		if !ok {
+			if sf.Anonymous && sf.Type.Kind() == reflect.Struct {
+				embedded, err := collectFields(sf.Type, idx)
+				if err != nil {
+					return nil, err
+				}
+				fields = append(fields, embedded...)
+			}
+			continue
+		}
+		if !sf.IsExported() {
+			continue
+		}
+		args := strings.Split(tag, ",")
+		if len(args) < 2 || args[1] == "" {
+			return nil, fmt.Errorf("bad jsonapi struct tag %q of %v.%s", tag, t, sf.Name)
+		}
+		f := field{
+			index:      idx,
+			goName:     sf.Name,
+			annotation: args[0],
+			name:       args[1],
+			typ:        sf.Type,
+		}
+		for _, opt := range args[2:] {
+			if opt == annotationOmitEmpty {
+				f.omitEmpty = true
+			}
+		}
+		switch f.annotation {
+		case annotationPrimary:
+			if sf.Type.Kind() != reflect.String {
+				return nil, fmt.Errorf("bad primary field %v.%s of type %v, need string", t, sf.Name, sf.Type)
+			}
+		case annotationAttribute, annotationRelation:
+		default:
+			return nil, fmt.Errorf("bad jsonapi struct tag %q of %v.%s", tag, t, sf.Name)
+		}
+		fields = append(fields, f)
+	}
+	return fields, nil
+}
+
+// primaryField returns the field of struct type t that holds the ID and the
+// resource type.
+func primaryField(t reflect.Type) (field, error) {
+	fields, err := typeFields(t)
+	if err != nil {
+		return field{}, err
+	}
+	for _, f := range fields {
+		if f.annotation == annotationPrimary {
+			return f, nil
+		}
+	}
+	return field{}, fmt.Errorf("%v has no primary jsonapi struct tag", t)
+}
+
+// FieldName returns the name of the Go struct field that holds the attribute
+// or relationship member of the resource type of v, e.g. Progress for
+// progress. Like Decode, it accepts a pointer to struct or a pointer to slice
+// of pointers to structs.
+func FieldName(v interface{}, member string) (string, bool) {
+	t := reflect.TypeOf(v)
+	for t != nil && (t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice) {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return "", false
+	}
+	fields, err := typeFields(t)
+	if err != nil {
+		return "", false
+	}
+	for _, f := range fields {
+		if f.annotation != annotationPrimary && f.name == member {
+			return f.goName, true
+		}
+	}
+	return "", false
+}
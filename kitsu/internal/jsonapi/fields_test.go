@@ -0,0 +1,28 @@
+package jsonapi
+
+import "testing"
+
+func TestFieldName(t *testing.T) {
+	tests := []struct {
+		v      interface{}
+		member string
+		want   string
+		ok     bool
+	}{
+		{new(Character), "name", "Name", true},
+		{new(Character), "voice", "Voice", true},
+		{&[]*Character{}, "name", "Name", true},
+		{new(Embedding), "createdAt", "CreatedAt", true},
+		{new(Character), "characters", "", false},
+		{new(Character), "id", "", false},
+		{new(Character), "", "", false},
+		{nil, "name", "", false},
+		{new(int), "name", "", false},
+	}
+	for _, tt := range tests {
+		got, ok := FieldName(tt.v, tt.member)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("FieldName(%T, %q) = %q, %v, want %q, %v", tt.v, tt.member, got, ok, tt.want, tt.ok)
+		}
+	}
+}
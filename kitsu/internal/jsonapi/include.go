@@ -0,0 +1,78 @@
+package jsonapi
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// ValidateInclude checks that path, a dot-separated list of relationship
+// names as used by the include query parameter, e.g. castings.person, can be
+// followed from resources of resourceType. Resource types and the types of
+// relationships of interface type are looked up among the types passed to
+// Register.
+func ValidateInclude(resourceType, path string) error {
+	t := registeredType(resourceType)
+	if t == nil {
+		return fmt.Errorf("unknown resource type %q", resourceType)
+	}
+	types := []reflect.Type{t}
+	for _, name := range strings.Split(path, ".") {
+		var next []reflect.Type
+		for _, t := range types {
+			fields, err := typeFields(t.Elem())
+			if err != nil {
+				return err
+			}
+			for _, f := range fields {
+				if f.annotation == annotationRelation && f.name == name {
+					next = append(next, relatedTypes(f.typ)...)
+				}
+			}
+		}
+		if len(next) == 0 {
+			return fmt.Errorf("bad include path %q: %q is not a relationship of %s", path, name, resourceTypes(types))
+		}
+		types = next
+	}
+	return nil
+}
+
+// relatedTypes returns the pointer to struct types that a relationship field
+// of type t can hold.
+func relatedTypes(t reflect.Type) []reflect.Type {
+	switch t.Kind() {
+	case reflect.Slice:
+		return relatedTypes(t.Elem())
+	case reflect.Ptr:
+		return []reflect.Type{t}
+	case reflect.Interface:
+		var types []reflect.Type
+		registry.Range(func(_, v interface{}) bool {
+			if rt := v.(reflect.Type); rt.Implements(t) {
+				types = append(types, rt)
+			}
+			return true
+		})
+		return types
+	}
+	return nil
+}
+
+// resourceTypes returns the sorted resource types of types for use in error
+// messages, e.g. "anime" or "anime, manga".
+func resourceTypes(types []reflect.Type) string {
+	var names []string
+	seen := make(map[string]bool)
+	for _, t := range types {
+		f, err := primaryField(t.Elem())
+		if err != nil || seen[f.name] {
+			continue
+		}
+		seen[f.name] = true
+		names = append(names, fmt.Sprintf("%q", f.name))
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,48 @@
+package jsonapi
+
+import "testing"
+
+func init() {
+	Register(new(Review), new(Person))
+}
+
+func TestValidateInclude(t *testing.T) {
+	tests := []struct {
+		resourceType string
+		path         string
+		ok           bool
+	}{
+		{"characters", "voice", true},
+		{"characters", "voice.characters", true},
+		{"characters", "voice.characters.voice", true},
+		{"people", "characters", true},
+		{"reviews", "subject", true},
+		{"characters", "name", false},   // Attribute.
+		{"characters", "voices", false}, // Typo.
+		{"characters", "voice.character", false},
+		{"characters", "", false},
+		{"characters", "voice..characters", false},
+		{"reviews", "subject.title", false}, // No relationships for films or books.
+		{"unknown", "voice", false},
+	}
+	for _, tt := range tests {
+		err := ValidateInclude(tt.resourceType, tt.path)
+		if tt.ok && err != nil {
+			t.Errorf("ValidateInclude(%q, %q) returned err: %v", tt.resourceType, tt.path, err)
+		}
+		if !tt.ok && err == nil {
+			t.Errorf("ValidateInclude(%q, %q) expected to return err", tt.resourceType, tt.path)
+		}
+	}
+}
+
+func TestValidateInclude_errorMessage(t *testing.T) {
+	err := ValidateInclude("reviews", "subject.author")
+	if err == nil {
+		t.Fatal("ValidateInclude expected to return err")
+	}
+	want := `bad include path "subject.author": "author" is not a relationship of "books", "films"`
+	if got := err.Error(); got != want {
+		t.Errorf("ValidateInclude err = %q, want %q", got, want)
+	}
+}
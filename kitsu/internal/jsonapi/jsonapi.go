@@ -1,22 +1,30 @@
 package jsonapi
 
 import (
-	"bytes"
 	"encoding/json"
 	"fmt"
 	"io"
 	"reflect"
 	"runtime"
-
-	"github.com/google/jsonapi"
 )
 
-func isZeroOfUnderlyingType(x interface{}) bool {
-	return reflect.DeepEqual(x, reflect.Zero(reflect.TypeOf(x)).Interface())
-}
-
 // Encode returns the JSON API encoding of v. It requires v to be a pointer to
-// struct or a slice of pointers to structs.
+// struct or a slice of pointers to structs. A value returned by Fields is also
+// accepted.
+//
+// The fields of the structs are described with jsonapi struct tags:
+//
+//	ID    string `jsonapi:"primary,anime"`
+//	Slug  string `jsonapi:"attr,slug,omitempty"`
+//	Genre *Genre `jsonapi:"relation,genre,omitempty"`
+//
+// Attributes are encoded following the encoding/json semantics, which means
+// that they can be of any type that encoding/json supports, including structs
+// and types that implement json.Marshaler. Relationships are encoded as
+// resource linkage and can be pointers to structs, slices of pointers to
+// structs or interfaces holding pointers to structs. Fields of embedded
+// structs that have no jsonapi struct tag are treated as if they belonged to
+// the outer struct.
 func Encode(w io.Writer, v interface{}) (err error) {
 	const errFormat = "cannot encode type %T, need pointer to struct or slice of pointers to structs"
 	defer func() {
@@ -27,40 +35,64 @@ func Encode(w io.Writer, v interface{}) (err error) {
 			err = fmt.Errorf("cannot encode type %T: %v", v, r)
 		}
 	}()
-	if isZeroOfUnderlyingType(v) {
-		return fmt.Errorf("cannot encode nil value of %#v", v)
+	if p, ok := v.(*partial); ok {
+		return encodePartial(w, p)
 	}
-	t := reflect.TypeOf(v)
-	switch t.Kind() {
-	default:
-		return fmt.Errorf(errFormat, v)
+	val := reflect.ValueOf(v)
+	switch val.Kind() {
 	case reflect.Ptr:
-		if t.Elem().Kind() != reflect.Struct {
+		if val.Type().Elem().Kind() != reflect.Struct {
 			return fmt.Errorf(errFormat, v)
 		}
-		return jsonapi.MarshalPayload(w, v)
 	case reflect.Slice:
-		s := reflect.ValueOf(v)
-		if s.Type().Elem().Kind() != reflect.Ptr {
+		elem := val.Type().Elem()
+		if elem.Kind() != reflect.Ptr || elem.Elem().Kind() != reflect.Struct {
 			return fmt.Errorf(errFormat, v)
 		}
-		if s.Type().Elem().Elem().Kind() != reflect.Struct {
-			return fmt.Errorf(errFormat, v)
-
-		}
-		return jsonapi.MarshalPayload(w, v)
+	default:
+		return fmt.Errorf(errFormat, v)
 	}
+	if val.IsNil() {
+		return fmt.Errorf("cannot encode nil value of %#v", v)
+	}
+	return encodePayload(w, val)
 }
 
-type extra struct {
-	Links *jsonapi.Links `json:"links,omitempty"`
-	Meta  *jsonapi.Meta  `json:"meta,omitempty"` // Not returned for now.
+// Document holds the top-level members of a decoded JSON API document, apart
+// from the primary data.
+type Document struct {
+	// Offset holds the pagination offsets parsed from the top-level links.
+	Offset Offset
+
+	// Meta holds the undecoded members of the top-level meta object. It is
+	// nil if the document has no meta object.
+	Meta map[string]json.RawMessage
+
+	// Included holds the included resources that were not attached to any
+	// relationship of the decoded value. They are pointers to the types passed
+	// to Register. Included resources of other types are left out.
+	Included []interface{}
 }
 
 // Decode parses the JSON API encoded data and stores the result in the value
 // pointed to by v. It requires v to be a pointer to struct or pointer to
-// slice.
-func Decode(r io.Reader, v interface{}) (offset Offset, err error) {
+// slice of pointers to structs.
+//
+// The same jsonapi struct tags as Encode are used. Attributes are decoded
+// following the encoding/json semantics. Relationships are resolved against
+// the included resources of the document. If a related resource is not
+// included, only its ID is set. All the references to the same resource share
+// a single pointer, even if the resources refer to each other in a cycle. A
+// relationship of interface type is decoded into the type passed to Register
+// for the resource type of its linkage.
+func Decode(r io.Reader, v interface{}) (Offset, error) {
+	d, err := DecodeDocument(r, v)
+	return d.Offset, err
+}
+
+// DecodeDocument is like Decode but it also returns the top-level members of
+// the document such as its meta object.
+func DecodeDocument(r io.Reader, v interface{}) (_ Document, err error) {
 	const errFormat = "cannot decode to %T, need pointer to struct or pointer to slice"
 	defer func() {
 		if r := recover(); r != nil {
@@ -71,41 +103,37 @@ func Decode(r io.Reader, v interface{}) (offset Offset, err error) {
 		}
 	}()
 	if reflect.TypeOf(v).Kind() != reflect.Ptr {
-		return Offset{}, fmt.Errorf(errFormat, v)
+		return Document{}, fmt.Errorf(errFormat, v)
 	}
 	val := reflect.Indirect(reflect.ValueOf(v))
-	switch val.Kind() {
-	default:
-		return Offset{}, fmt.Errorf(errFormat, v)
-	case reflect.Struct:
-		return Offset{}, jsonapi.UnmarshalPayload(r, v)
-	case reflect.Slice:
-		var buf bytes.Buffer
-		tee := io.TeeReader(r, &buf)
+	if k := val.Kind(); k != reflect.Struct && k != reflect.Slice {
+		return Document{}, fmt.Errorf(errFormat, v)
+	}
 
-		// Decode data.
-		data, uerr := jsonapi.UnmarshalManyPayload(tee, val.Type().Elem())
-		if uerr != nil {
-			return Offset{}, uerr
-		}
-		for _, d := range data {
-			val.Set(reflect.Append(val, reflect.ValueOf(d)))
-		}
+	doc := new(document)
+	if err := json.NewDecoder(r).Decode(doc); err != nil {
+		return Document{}, err
+	}
 
-		// Decode links.
-		x := new(extra)
-		if err := json.NewDecoder(&buf).Decode(x); err != nil {
-			return Offset{}, err
-		}
+	// Decode data.
+	dec := newDecoder(doc)
+	if err := dec.decodeData(doc.Data, val); err != nil {
+		return Document{}, err
+	}
+	included, err := dec.unattached()
+	if err != nil {
+		return Document{}, err
+	}
 
-		o := Offset{}
-		var perr error
-		if x.Links != nil {
-			o, perr = parseOffset(*x.Links)
-			if perr != nil {
-				return Offset{}, perr
-			}
+	// Decode links and meta.
+	d := Document{Included: included}
+	if doc.Links != nil {
+		o, perr := parseOffset(*doc.Links)
+		if perr != nil {
+			return Document{}, perr
 		}
-		return o, nil
+		d.Offset = o
 	}
+	d.Meta = doc.Meta
+	return d, nil
 }
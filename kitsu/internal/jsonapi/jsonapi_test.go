@@ -176,3 +176,54 @@ func TestDecode_toSliceForSinglePayload(t *testing.T) {
 		t.Errorf("Decode(%v, %T) expected to return err", in, &anime)
 	}
 }
+
+func TestDecodeDocument_meta(t *testing.T) {
+	var tests = []struct {
+		in string
+		v  interface{}
+	}{
+		{`{"data":{"type":"anime","id":"1"},"meta":{"count":1}}`, new(Anime)},
+		{`{"data":[{"type":"anime","id":"1"}],"meta":{"count":1}}`, &[]*Anime{}},
+	}
+	for _, tt := range tests {
+		d, err := DecodeDocument(strings.NewReader(tt.in), tt.v)
+		if err != nil {
+			t.Fatalf("DecodeDocument(%s) returned err: %v", tt.in, err)
+		}
+		if got, want := string(d.Meta["count"]), "1"; got != want {
+			t.Errorf("DecodeDocument(%s) meta count = %q, want %q", tt.in, got, want)
+		}
+	}
+}
+
+func TestDecodeDocument_noMeta(t *testing.T) {
+	in := `{"data":{"type":"anime","id":"1"}}`
+	d, err := DecodeDocument(strings.NewReader(in), new(Anime))
+	if err != nil {
+		t.Fatalf("DecodeDocument returned err: %v", err)
+	}
+	if d.Meta != nil {
+		t.Errorf("DecodeDocument meta = %v, want nil", d.Meta)
+	}
+}
+
+func TestDecodeDocument_included(t *testing.T) {
+	in := `{
+		"data":{"type":"reviews","id":"1","relationships":{"subject":{"data":{"type":"films","id":"2"}}}},
+		"included":[
+			{"type":"films","id":"2","attributes":{"title":"Cowboy Bebop: The Movie"}},
+			{"type":"games","id":"3"},
+			{"type":"books","id":"4","attributes":{"title":"Cowboy Bebop Shooting Star"}}
+		]
+	}`
+	d, err := DecodeDocument(strings.NewReader(in), new(Review))
+	if err != nil {
+		t.Fatalf("DecodeDocument returned err: %v", err)
+	}
+
+	// Film 2 is attached to the review and games are not registered.
+	want := []interface{}{&Book{ID: "4", Title: "Cowboy Bebop Shooting Star"}}
+	if !reflect.DeepEqual(d.Included, want) {
+		t.Errorf("DecodeDocument included\nhave: %#v\nwant: %#v", d.Included, want)
+	}
+}
@@ -4,17 +4,21 @@ import (
 	"fmt"
 	"net/url"
 	"strconv"
-
-	"github.com/google/jsonapi"
 )
 
+// Links represents a JSON API links object. Each member is either a string
+// containing a URL or a link object.
+//
+// JSON API docs: http://jsonapi.org/format/#document-links
+type Links map[string]interface{}
+
 // Offset represents the pagination offset returned in the API response when
 // there's a list of resources.
 type Offset struct {
 	First, Last, Prev, Next int
 }
 
-func parseOffset(links jsonapi.Links) (Offset, error) {
+func parseOffset(links Links) (Offset, error) {
 	m := map[string]int{"first": 0, "last": 0, "prev": 0, "next": 0}
 	var err error
 
@@ -3,12 +3,10 @@ package jsonapi
 import (
 	"reflect"
 	"testing"
-
-	"github.com/google/jsonapi"
 )
 
 func Test_parseOffset(t *testing.T) {
-	links := jsonapi.Links{
+	links := Links{
 		"first": "http://somesite.com/movies?page[limit]=50&page[offset]=50",
 		"prev":  "http://somesite.com/movies?page[limit]=50&page[offset]=0",
 		"next":  "http://somesite.com/movies?page[limit]=50&page[offset]=100",
@@ -25,7 +23,7 @@ func Test_parseOffset(t *testing.T) {
 }
 
 func Test_parseOffset_structLink(t *testing.T) {
-	structLinks := []jsonapi.Links{
+	structLinks := []Links{
 		{"first": struct{}{}},
 	}
 	for _, link := range structLinks {
@@ -37,7 +35,7 @@ func Test_parseOffset_structLink(t *testing.T) {
 }
 
 func Test_parseOffset_pageNumberAndSize(t *testing.T) {
-	links := jsonapi.Links{
+	links := Links{
 		"first": "http://example.com?page[number]=1&page[size]=50",
 		"prev":  "http://example.com?page[number]=13&page[size]=50",
 		"next":  "http://example.com?page[number]=15&page[size]=50",
@@ -56,7 +54,7 @@ func Test_parseOffset_pageNumberAndSize(t *testing.T) {
 }
 
 func Test_parseOffset_badLinks(t *testing.T) {
-	badLinks := []jsonapi.Links{
+	badLinks := []Links{
 		{"first": ":"},
 		{"prev": ":"},
 		{"next": ":"},
@@ -0,0 +1,41 @@
+package jsonapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+)
+
+// partial holds a resource of which only some attributes are to be encoded.
+type partial struct {
+	v     interface{}
+	names []string
+}
+
+// Fields wraps v, which must be a pointer to struct, so that Encode only
+// encodes the type, the ID and the attributes of v with the given names.
+// Unlike a regular encoding, the named attributes are encoded even if they
+// hold zero values and are tagged with omitempty. This allows to send partial
+// updates that intentionally set attributes to values like 0, false or "".
+func Fields(v interface{}, names ...string) interface{} {
+	return &partial{v: v, names: names}
+}
+
+func encodePartial(w io.Writer, p *partial) error {
+	const errFormat = "cannot encode fields of type %T, need pointer to struct"
+	val := reflect.ValueOf(p.v)
+	if val.Kind() != reflect.Ptr || val.IsNil() || val.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf(errFormat, p.v)
+	}
+
+	only := make(map[string]bool, len(p.names))
+	for _, name := range p.names {
+		only[name] = true
+	}
+	n, err := encodeResource(val.Elem(), only)
+	if err != nil {
+		return fmt.Errorf("cannot encode fields of type %T: %v", p.v, err)
+	}
+	return json.NewEncoder(w).Encode(onePayload{Data: n})
+}
@@ -0,0 +1,60 @@
+package jsonapi
+
+import (
+	"bytes"
+	"testing"
+)
+
+type LibraryEntry struct {
+	ID       string `jsonapi:"primary,libraryEntries"`
+	Progress int    `jsonapi:"attr,progress,omitempty"`
+	Private  bool   `jsonapi:"attr,private,omitempty"`
+	Notes    string `jsonapi:"attr,notes,omitempty"`
+	Anime    *Anime `jsonapi:"relation,anime,omitempty"`
+}
+
+func TestEncode_fields(t *testing.T) {
+	in := &LibraryEntry{ID: "5", Progress: 0, Notes: "note", Anime: &Anime{ID: "1"}}
+	out := `{"data":{"type":"libraryEntries","id":"5","attributes":{"private":false,"progress":0}}}` + "\n"
+
+	buf := &bytes.Buffer{}
+	if err := Encode(buf, Fields(in, "progress", "private")); err != nil {
+		t.Fatalf("Encode returned err: %v", err)
+	}
+	if got, want := buf.String(), out; got != want {
+		t.Errorf("Encode \nhave: %q\nwant: %q", got, want)
+	}
+}
+
+func TestEncode_fieldsNone(t *testing.T) {
+	in := &LibraryEntry{ID: "5", Progress: 3}
+	out := `{"data":{"type":"libraryEntries","id":"5"}}` + "\n"
+
+	buf := &bytes.Buffer{}
+	if err := Encode(buf, Fields(in)); err != nil {
+		t.Fatalf("Encode returned err: %v", err)
+	}
+	if got, want := buf.String(), out; got != want {
+		t.Errorf("Encode \nhave: %q\nwant: %q", got, want)
+	}
+}
+
+func TestEncode_fieldsUnknownAttribute(t *testing.T) {
+	buf := &bytes.Buffer{}
+	in := &LibraryEntry{ID: "5"}
+	for _, name := range []string{"progres", "anime"} {
+		if err := Encode(buf, Fields(in, name)); err == nil {
+			t.Errorf("Encode(Fields(%T, %q)) expected to return err", in, name)
+		}
+	}
+}
+
+func TestEncode_fieldsInvalidType(t *testing.T) {
+	buf := &bytes.Buffer{}
+	var tests = []interface{}{1, LibraryEntry{}, (*LibraryEntry)(nil)}
+	for _, in := range tests {
+		if err := Encode(buf, Fields(in)); err == nil {
+			t.Errorf("Encode(Fields(%#v)) expected to return err", in)
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package jsonapi
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+var registry sync.Map // map[string]reflect.Type
+
+// Register records the concrete types of values, which must be pointers to
+// structs with a primary jsonapi struct tag, under their resource types. When
+// Decode meets a relationship field of interface type, it uses the resource
+// type of the linkage to pick the registered concrete type to decode into.
+//
+// Register is meant to be called during initialization. It panics if a value
+// is not a pointer to struct, has no primary field or if its resource type is
+// already registered to a different Go type.
+func Register(values ...interface{}) {
+	for _, v := range values {
+		t := reflect.TypeOf(v)
+		if t == nil || t.Kind() != reflect.Ptr || t.Elem().Kind() != reflect.Struct {
+			panic(fmt.Sprintf("jsonapi: cannot register type %T, need pointer to struct", v))
+		}
+		f, err := primaryField(t.Elem())
+		if err != nil {
+			panic("jsonapi: " + err.Error())
+		}
+		if prev, loaded := registry.LoadOrStore(f.name, t); loaded && prev != t {
+			panic(fmt.Sprintf("jsonapi: resource type %q registered for both %v and %v", f.name, prev, t))
+		}
+	}
+}
+
+// Registered reports whether a type was passed to Register for the resource
+// type typ.
+func Registered(typ string) bool {
+	return registeredType(typ) != nil
+}
+
+// registeredType returns the type that was registered for the resource type
+// typ or nil if there is none.
+func registeredType(typ string) reflect.Type {
+	if t, ok := registry.Load(typ); ok {
+		return t.(reflect.Type)
+	}
+	return nil
+}
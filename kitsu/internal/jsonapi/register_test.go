@@ -0,0 +1,95 @@
+package jsonapi
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type Subject interface{ subject() }
+
+type Film struct {
+	ID    string `jsonapi:"primary,films"`
+	Title string `jsonapi:"attr,title"`
+}
+
+func (*Film) subject() {}
+
+type Book struct {
+	ID    string `jsonapi:"primary,books"`
+	Title string `jsonapi:"attr,title"`
+}
+
+func (*Book) subject() {}
+
+type Review struct {
+	ID      string  `jsonapi:"primary,reviews"`
+	Subject Subject `jsonapi:"relation,subject"`
+}
+
+func init() {
+	Register(new(Film), new(Book), new(Character))
+}
+
+func TestDecode_interfaceRelationship(t *testing.T) {
+	in := `{
+		"data":[
+			{"type":"reviews","id":"1","relationships":{"subject":{"data":{"type":"films","id":"2"}}}},
+			{"type":"reviews","id":"3","relationships":{"subject":{"data":{"type":"books","id":"4"}}}},
+			{"type":"reviews","id":"5","relationships":{"subject":{"data":{"type":"characters","id":"6"}}}},
+			{"type":"reviews","id":"7","relationships":{"subject":{"data":{"type":"games","id":"8"}}}}
+		],
+		"included":[{"type":"films","id":"2","attributes":{"title":"Cowboy Bebop: The Movie"}}]
+	}`
+
+	var got []*Review
+	if _, err := Decode(strings.NewReader(in), &got); err != nil {
+		t.Fatalf("Decode returned err: %v", err)
+	}
+
+	// Characters are registered but do not satisfy Subject while games are
+	// not registered at all so both are skipped.
+	want := []*Review{
+		{ID: "1", Subject: &Film{ID: "2", Title: "Cowboy Bebop: The Movie"}},
+		{ID: "3", Subject: &Book{ID: "4"}},
+		{ID: "5"},
+		{ID: "7"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Decode \nhave: %#v\nwant: %#v", got, want)
+	}
+}
+
+func TestRegister_panics(t *testing.T) {
+	type Duplicate struct {
+		ID string `jsonapi:"primary,films"`
+	}
+	tests := []struct {
+		name string
+		v    interface{}
+	}{
+		{"nil", nil},
+		{"non pointer", Film{}},
+		{"no primary field", new(struct{ Title string })},
+		{"duplicate resource type", new(Duplicate)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("Register(%#v) expected to panic", tt.v)
+				}
+			}()
+			Register(tt.v)
+		})
+	}
+}
+
+func TestRegistered(t *testing.T) {
+	if !Registered("films") {
+		t.Error("Registered(films) = false, want true")
+	}
+	if Registered("unknown") {
+		t.Error("Registered(unknown) = true, want false")
+	}
+}
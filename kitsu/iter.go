@@ -0,0 +1,76 @@
+package kitsu
+
+import (
+	"context"
+	"iter"
+)
+
+// listFunc is the signature shared by the List methods of the services.
+type listFunc[T any] func(ctx context.Context, opts ...URLOption) ([]T, *Response, error)
+
+// all returns an iterator that walks through every page of results returned
+// by list, starting from the page selected by opts. Each subsequent page is
+// retrieved by following the offset of the next link of the previous page
+// while keeping the rest of the options, including Limit, untouched.
+//
+// If an error occurs while retrieving a page, it is yielded together with the
+// zero value of T and the iteration stops.
+func all[T any](ctx context.Context, list listFunc[T], opts []URLOption) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		pageOpts := opts
+		offset := 0
+		for {
+			items, resp, err := list(ctx, pageOpts...)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			// The last page has no next link which results in a zero offset.
+			// Also guard against links that do not make progress.
+			next := resp.Offset.Next
+			if len(items) == 0 || next == 0 || next <= offset {
+				return
+			}
+			offset = next
+
+			// Offset is appended last so that it overrides any offset the
+			// caller might have set with the Pagination or Offset options.
+			pageOpts = append(opts[:len(opts):len(opts)], Offset(offset))
+		}
+	}
+}
+
+// Take returns an iterator over the first n values of seq. It stops seq, and
+// with it the retrieval of more pages by the All methods of the services,
+// once n values have been yielded:
+//
+//	// The 50 most popular anime, retrieved 20 at a time.
+//	for a, err := range kitsu.Take(client.Anime.All(ctx, kitsu.Sort("popularityRank"), kitsu.Limit(20)), 50) {
+//		// ...
+//	}
+//
+// An error yielded by seq counts towards n.
+func Take[T any](seq iter.Seq2[T, error], n int) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		if n <= 0 {
+			return
+		}
+		taken := 0
+		for v, err := range seq {
+			if !yield(v, err) {
+				return
+			}
+			taken++
+			if taken >= n {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package kitsu
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestAnimeService_All(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/"+defaultAPIVersion+"anime", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", defaultMediaType)
+		switch offset := r.FormValue("page[offset]"); offset {
+		case "":
+			testFormValues(t, r, values{
+				"page[limit]":    "2",
+				"filter[genres]": "sports",
+			})
+			fmt.Fprint(w, `{
+				"data":[{"id":"1","type":"anime"},{"id":"2","type":"anime"}],
+				"links":{"next":"https://kitsu.io/api/edge/anime?page%5Blimit%5D=2&page%5Boffset%5D=2"}
+			}`)
+		case "2":
+			testFormValues(t, r, values{
+				"page[limit]":    "2",
+				"page[offset]":   "2",
+				"filter[genres]": "sports",
+			})
+			fmt.Fprint(w, `{
+				"data":[{"id":"3","type":"anime"}],
+				"links":{"prev":"https://kitsu.io/api/edge/anime?page%5Blimit%5D=2&page%5Boffset%5D=0"}
+			}`)
+		default:
+			t.Errorf("unexpected page[offset] = %q", offset)
+		}
+	})
+
+	var got []string
+	for a, err := range client.Anime.All(context.Background(), Limit(2), Filter("genres", "sports")) {
+		if err != nil {
+			t.Fatalf("Anime.All returned error: %v", err)
+		}
+		got = append(got, a.ID)
+	}
+
+	if want := []string{"1", "2", "3"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Anime.All IDs = %v, want %v", got, want)
+	}
+}
+
+func TestUserService_All_break(t *testing.T) {
+	setup()
+	defer teardown()
+
+	requests := 0
+	mux.HandleFunc("/"+defaultAPIVersion+"users", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		fmt.Fprint(w, `{
+			"data":[{"id":"1","type":"users"},{"id":"2","type":"users"}],
+			"links":{"next":"https://kitsu.io/api/edge/users?page%5Blimit%5D=2&page%5Boffset%5D=2"}
+		}`)
+	})
+
+	var got []string
+	for u, err := range client.User.All(context.Background()) {
+		if err != nil {
+			t.Fatalf("User.All returned error: %v", err)
+		}
+		got = append(got, u.ID)
+		break
+	}
+
+	if want := []string{"1"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("User.All IDs = %v, want %v", got, want)
+	}
+	if requests != 1 {
+		t.Errorf("User.All made %d requests after break, want 1", requests)
+	}
+}
+
+func TestTake(t *testing.T) {
+	setup()
+	defer teardown()
+
+	requests := 0
+	mux.HandleFunc("/"+defaultAPIVersion+"anime", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		offset, _ := strconv.Atoi(r.FormValue("page[offset]"))
+		fmt.Fprintf(w, `{
+			"data":[{"id":"%d","type":"anime"},{"id":"%d","type":"anime"}],
+			"links":{"next":"https://kitsu.io/api/edge/anime?page%%5Blimit%%5D=2&page%%5Boffset%%5D=%d"}
+		}`, offset+1, offset+2, offset+2)
+	})
+
+	var got []string
+	for a, err := range Take(client.Anime.All(context.Background(), Limit(2)), 3) {
+		if err != nil {
+			t.Fatalf("Anime.All returned error: %v", err)
+		}
+		got = append(got, a.ID)
+	}
+
+	if want := []string{"1", "2", "3"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Take(Anime.All) IDs = %v, want %v", got, want)
+	}
+	if requests != 2 {
+		t.Errorf("Take(Anime.All) made %d requests, want 2", requests)
+	}
+
+	requests = 0
+	for range Take(client.Anime.All(context.Background()), 0) {
+		t.Error("Take with n = 0 yielded a value")
+	}
+	if requests != 0 {
+		t.Errorf("Take with n = 0 made %d requests, want 0", requests)
+	}
+}
+
+func TestLibraryService_All_error(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/"+defaultAPIVersion+"library-entries", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"errors":[{"status":"400","title":"Bad Request"}]}`, http.StatusBadRequest)
+	})
+
+	var errs int
+	for e, err := range client.Library.All(context.Background()) {
+		if err == nil {
+			t.Fatalf("Library.All expected to yield error, got entry %#v", e)
+		}
+		if e != nil {
+			t.Errorf("Library.All yielded entry %#v together with error", e)
+		}
+		errs++
+	}
+	if errs != 1 {
+		t.Errorf("Library.All yielded %d errors, want 1", errs)
+	}
+}
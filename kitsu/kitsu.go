@@ -2,17 +2,23 @@ package kitsu
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/nstratos/go-kitsu/kitsu/internal/jsonapi"
 )
 
+//go:generate go run ./internal/cmd/genfields -output fields_gen.go
+
 const (
 	defaultBaseURL    = "https://kitsu.io/"
 	defaultAPIVersion = "api/edge/"
@@ -26,17 +32,102 @@ type Client struct {
 
 	BaseURL *url.URL
 
+	// RetryPolicy controls whether failed requests are retried. Requests are
+	// not retried when it is nil.
+	RetryPolicy *RetryPolicy
+
+	// RateLimiter, if set, is waited on before sending each request,
+	// including retries.
+	RateLimiter RateLimiter
+
+	// ValidateIncludes enables checking the paths of the Include option
+	// against the relationships of the requested resource type. When set,
+	// NewRequest returns an error for a path that does not exist, e.g.
+	// "animeStaff.persons" instead of "animeStaff.person", instead of
+	// sending a request that returns the relationship undecoded or fails.
+	// Only requests for a resource collection or a single resource, e.g.
+	// anime or anime/1, of a resource type that this package has a model
+	// for can be checked. Other requests are sent unchecked.
+	ValidateIncludes bool
+
+	// Cache, if set, stores the responses of GET requests so that they can
+	// be reused. See Cache for the details, including the limits of its
+	// invalidation. Responses are not cached when it is nil.
+	Cache Cache
+
+	// CacheScope, if set, returns the identity that the response of req is
+	// cached for, e.g. the ID of the authenticated user, so that a Cache
+	// shared by clients with different credentials never serves the private
+	// responses of one to another. By default, responses are scoped by the
+	// Authorization header of the request, if any. Clients whose
+	// http.Client adds the credentials itself, like the ones returned by
+	// oauth2.Config.Client, must set it when they share a Cache. The clients
+	// returned by auth.NewClient set it.
+	CacheScope func(req *http.Request) string
+
+	// DeduplicateRequests enables collapsing concurrent GET requests for the
+	// same URL, including its query, into a single HTTP call. Requests are
+	// only collapsed within the same credential scope, as determined by
+	// CacheScope or, if it is nil, the Authorization header. Each caller
+	// decodes its own copy of the shared response so the returned values are
+	// never shared between callers.
+	DeduplicateRequests bool
+
+	// Logger, if set, receives a debug level record for each request sent,
+	// each response received and each retry, with the method, URL, status,
+	// latency and attempt of the request. When built with -tags=debug, the
+	// clients without a Logger log to stdout.
+	Logger *slog.Logger
+
+	// LogBodies adds a dump of the requests and responses, including their
+	// bodies, to the records of Logger. The value of the Authorization header
+	// is redacted. Bodies are always logged when built with -tags=debug.
+	LogBodies bool
+
+	// Middleware wraps each call of Do, in order: the first Middleware is the
+	// outermost, it sees the request first and the response last. The
+	// middleware runs once per call of Do, around the retries, the cache
+	// and the deduplication of the request, and receives the decoded
+	// Response. Use appends to it.
+	Middleware []Middleware
+
+	flights flightGroup
+
 	common service
 
-	Anime   *AnimeService
-	User    *UserService
-	Library *LibraryService
+	Anime           *AnimeService
+	Manga           *MangaService
+	Drama           *DramaService
+	Character       *CharacterService
+	Person          *PersonService
+	AnimeCharacter  *AnimeCharacterService
+	AnimeStaff      *AnimeStaffService
+	AnimeProduction *AnimeProductionService
+	MangaCharacter  *MangaCharacterService
+	MangaStaff      *MangaStaffService
+	User            *UserService
+	Library         *LibraryService
 }
 
 type service struct {
 	client *Client
 }
 
+func init() {
+	// Register the resources of the package so that relationships of
+	// interface type, like LibraryEntry.Media, and Response.Included are
+	// decoded into their concrete types.
+	jsonapi.Register(
+		new(Anime), new(Manga), new(Drama),
+		new(Genre), new(Mapping), new(Casting),
+		new(Character), new(Person),
+		new(AnimeCharacter), new(AnimeStaff),
+		new(AnimeProduction), new(Producer),
+		new(MangaCharacter), new(MangaStaff),
+		new(User), new(LibraryEntry),
+	)
+}
+
 // NewClient returns a new kitsu.io API client.
 func NewClient(httpClient *http.Client) *Client {
 	if httpClient == nil {
@@ -49,6 +140,15 @@ func NewClient(httpClient *http.Client) *Client {
 	c.common.client = c
 
 	c.Anime = (*AnimeService)(&c.common)
+	c.Manga = (*MangaService)(&c.common)
+	c.Drama = (*DramaService)(&c.common)
+	c.Character = (*CharacterService)(&c.common)
+	c.Person = (*PersonService)(&c.common)
+	c.AnimeCharacter = (*AnimeCharacterService)(&c.common)
+	c.AnimeStaff = (*AnimeStaffService)(&c.common)
+	c.AnimeProduction = (*AnimeProductionService)(&c.common)
+	c.MangaCharacter = (*MangaCharacterService)(&c.common)
+	c.MangaStaff = (*MangaStaffService)(&c.common)
 	c.User = (*UserService)(&c.common)
 	c.Library = (*LibraryService)(&c.common)
 
@@ -99,12 +199,22 @@ func Offset(offset int) URLOption {
 //
 // Some resources support additional filters.
 //
-// Anime: text, season, streamers
+// Anime: text, season, streamers (see also AnimeQuery)
 //
 // Manga: text
 //
 // Drama: text
 //
+// Character: name, slug
+//
+// Person: name
+//
+// AnimeCharacter, AnimeStaff: animeId
+//
+// AnimeProduction: animeId, producerId
+//
+// MangaCharacter, MangaStaff: mangaId
+//
 // LibraryEntry: userId
 func Filter(attribute string, values ...string) URLOption {
 	return func(v *url.Values) {
@@ -117,7 +227,9 @@ func Filter(attribute string, values ...string) URLOption {
 //
 // Search can only be used for media such as Anime and Manga. Passing the
 // search option to one of the User methods will return an error. Alternatively
-// the Filter option with the "name" attribute could be used instead.
+// the Filter option with the "name" attribute could be used instead. Characters
+// and people can be searched by name with CharacterService.Search and
+// PersonService.Search.
 func Search(query string) URLOption {
 	return func(v *url.Values) {
 		v.Set("filter[text]", query)
@@ -160,6 +272,38 @@ func Include(relationships ...string) URLOption {
 	}
 }
 
+// Fields allows to request only specific attributes and relationships, also
+// known as a sparse fieldset, for the resources of a resource type. This
+// reduces the size of the response when only a few attributes are needed.
+// For example to receive only the canonical title and episode count of Anime:
+//
+//	Fields("anime", "canonicalTitle", "episodeCount")
+//
+// Each resource type, including the types of included resources, can have
+// its own fieldset by passing many Fields options:
+//
+//	Include("castings.person"),
+//	Fields("anime", "castings"),
+//	Fields("people", "name"),
+//
+// Each resource has a typed helper, e.g. AnimeFields, which avoids typos in
+// the resource type and field names:
+//
+//	AnimeFields(AnimeFieldCanonicalTitle, AnimeFieldEpisodeCount)
+func Fields(resourceType string, fields ...string) URLOption {
+	return func(v *url.Values) {
+		v.Set("fields["+resourceType+"]", strings.Join(fields, ","))
+	}
+}
+
+func fieldNames[T ~string](fields []T) []string {
+	names := make([]string, len(fields))
+	for i, f := range fields {
+		names[i] = string(f)
+	}
+	return names
+}
+
 // NewRequest creates an API request. If a relative URL is provided in urlStr,
 // it will be resolved relative to the BaseURL of the Client. Relative URLs
 // should always be specified without a preceding slash. If body is specified,
@@ -188,6 +332,12 @@ func (c *Client) NewRequest(method, urlStr string, body interface{}, opts ...URL
 
 	u := c.BaseURL.ResolveReference(rel)
 
+	if c.ValidateIncludes && v.Get("include") != "" {
+		if err := c.validateIncludes(u, v.Get("include")); err != nil {
+			return nil, err
+		}
+	}
+
 	req, err := http.NewRequest(method, u.String(), buf)
 	if err != nil {
 		return nil, err
@@ -201,19 +351,94 @@ func (c *Client) NewRequest(method, urlStr string, body interface{}, opts ...URL
 	return req, nil
 }
 
+// validateIncludes checks the comma-separated include paths against the
+// resource type of the request URL u. The resource type is derived from the
+// first path segment after the API version, e.g. library-entries for
+// libraryEntries.
+func (c *Client) validateIncludes(u *url.URL, include string) error {
+	apiPath := c.BaseURL.ResolveReference(&url.URL{Path: defaultAPIVersion}).Path
+	p, ok := strings.CutPrefix(u.Path, apiPath)
+	if !ok {
+		return nil
+	}
+	segments := strings.Split(strings.Trim(p, "/"), "/")
+	if len(segments) > 2 {
+		return nil
+	}
+	resourceType := kebabToCamel(segments[0])
+	if !jsonapi.Registered(resourceType) {
+		// Resources without a model, e.g. episodes, cannot be checked.
+		return nil
+	}
+	for _, path := range strings.Split(include, ",") {
+		if err := jsonapi.ValidateInclude(resourceType, path); err != nil {
+			return fmt.Errorf("kitsu: %v", err)
+		}
+	}
+	return nil
+}
+
+// kebabToCamel converts a URL path segment like library-entries to the
+// corresponding resource type libraryEntries.
+func kebabToCamel(s string) string {
+	parts := strings.Split(s, "-")
+	for i := 1; i < len(parts); i++ {
+		if parts[i] != "" {
+			parts[i] = strings.ToUpper(parts[i][:1]) + parts[i][1:]
+		}
+	}
+	return strings.Join(parts, "")
+}
+
 // Response is a Kitsu API response. It wraps the standard http.Response
 // returned from the request and provides access to pagination offsets for
-// responses that return many results.
+// responses that return many results as well as to the top-level meta
+// information of the JSON API document.
 type Response struct {
 	*http.Response
 
 	Offset PageOffset
+	Meta   Meta
+
+	// Included holds the resources requested with the Include option that
+	// were not attached to any relationship of the returned values. Their
+	// concrete types are the resource types of this package, e.g. *Person,
+	// and can be retrieved with a type switch. Related resources that are
+	// attached are not repeated here.
+	Included []interface{}
+}
+
+// Meta holds the top-level meta object of the JSON API document that was
+// returned in the API response. List endpoints usually include the total
+// number of resources in it, which is convenient to calculate the number of
+// pages:
+//
+//	pages := (resp.Meta.Count + limit - 1) / limit
+type Meta struct {
+	// Count is the total number of resources that match the request. It is
+	// zero if the API did not return a count.
+	Count int
+
+	// Raw provides access to all the members of the meta object, e.g.
+	// "statusCounts" for library entries, so that they can be decoded by the
+	// caller with json.Unmarshal. It is nil if there was no meta object.
+	Raw map[string]json.RawMessage
+}
+
+func makeMeta(raw map[string]json.RawMessage) Meta {
+	m := Meta{Raw: raw}
+	if count, ok := raw["count"]; ok {
+		// The count is informational so a malformed value is ignored.
+		_ = json.Unmarshal(count, &m.Count)
+	}
+	return m
 }
 
 // PageOffset holds the offset values for each pagination link that is returned
 // in the JSON API document. It is contained in the Response that is returned
 // from each method of the API. A common usage is to use the Next value
-// together with the Pagination option to access the next page of results.
+// together with the Pagination option to access the next page of results. The
+// All methods of the services take care of this automatically.
 type PageOffset struct {
 	Next, Prev, First, Last int
 }
@@ -241,27 +466,47 @@ func newResponse(r *http.Response) *Response {
 // Decoding requires v to be a pointer to a struct. For example:
 //
 //	a := new(Anime)
-//	c.client.Do(req, a)
+//	c.client.Do(ctx, req, a)
 //
 // Alternatively you may pass the address of a slice of pointers to structs:
 //
 //	var anime []*Anime
-//	c.client.Do(req, &anime)
+//	c.client.Do(ctx, req, &anime)
+//
+// The provided ctx must be non-nil. If it is canceled or times out, the
+// in-flight request and the decoding of its body are aborted and ctx.Err() is
+// returned.
 //
 // Do closes the response body on return.
-func (c *Client) Do(req *http.Request, v interface{}) (*Response, error) {
+//
+// The request passes through the middleware of the client, if any, before it
+// is sent. See Client.Middleware.
+func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
+	if ctx == nil {
+		return nil, errNilContext
+	}
+	return c.handler()(ctx, req, v)
+}
+
+// do is the Handler that sends the request and decodes the response, at the
+// end of the middleware chain.
+func (c *Client) do(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
+	req = req.WithContext(ctx)
 
 	// Do HTTP request.
-	dumpRequest(req, true) // only when built with -tags=debug
-	resp, err := c.client.Do(req)
+	resp, err := c.dedupSend(ctx, req)
 	if err != nil {
+		// If we got an error and the context has been canceled, the context's
+		// error is probably more useful.
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, ctxErr
+		}
 		return nil, err
 	}
 	defer resp.Body.Close()
-	dumpResponse(resp, true) // only when built with -tags=debug
 
 	// Check response for errors.
-	if err = checkResponse(resp); err != nil {
+	if err = checkResponse(resp, v); err != nil {
 		// Despite the error, the response is still returned in case the caller
 		// wishes to inspect it further.
 		return newResponse(resp), err
@@ -273,60 +518,60 @@ func (c *Client) Do(req *http.Request, v interface{}) (*Response, error) {
 	}
 
 	// Decode response body to v.
-	o, err := jsonapi.Decode(resp.Body, v)
+	doc, err := jsonapi.DecodeDocument(resp.Body, v)
 	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return newResponse(resp), ctxErr
+		}
 		return newResponse(resp), err
 	}
 	response := &Response{
 		Response: resp,
-		Offset:   makePageOffset(o),
+		Offset:   makePageOffset(doc.Offset),
+		Meta:     makeMeta(doc.Meta),
+		Included: doc.Included,
 	}
 
 	return response, nil
 }
 
-// ErrorResponse reports one or more errors caused by an API request.
-type ErrorResponse struct {
-	Response *http.Response // HTTP response that caused this error
-	Errors   []Error        `json:"errors"`
-}
-
-func (r *ErrorResponse) Error() string {
-	return fmt.Sprintf("%v %v: %d %+v",
-		r.Response.Request.Method, r.Response.Request.URL,
-		r.Response.StatusCode, r.Errors)
-}
-
-// Error holds the details of each individual error in an ErrorResponse.
-//
-// JSON API docs: http://jsonapi.org/format/#error-objects
-type Error struct {
-	Title  string `json:"title"`
-	Detail string `json:"detail"`
-	Code   string `json:"code"`
-	Status string `json:"status"`
-}
+// send sends req and returns the HTTP response. Before each attempt it waits
+// for the RateLimiter and, in case of failure, it retries the request
+// according to the RetryPolicy of the client.
+func (c *Client) send(ctx context.Context, req *http.Request) (*http.Response, error) {
+	for attempt := 1; ; attempt++ {
+		if c.RateLimiter != nil {
+			if err := c.RateLimiter.Wait(ctx); err != nil {
+				return nil, err
+			}
+		}
+		if attempt > 1 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
 
-func (e *Error) Error() string {
-	return fmt.Sprintf("%v: error %v: %v(%v)",
-		e.Status, e.Code, e.Title, e.Detail)
-}
+		c.logRequest(ctx, req, attempt)
+		start := time.Now()
+		resp, err := c.client.Do(req)
+		c.logResponse(ctx, req, resp, err, attempt, time.Since(start))
 
-// checkResponse checks the API response for errors and returns them if
-// present. A response is considered an error if it has a status code outside
-// the 200 range.
-//
-// API error responses are expected to have either no response body, or a JSON
-// response body that maps to ErrorResponse. Any other response body will be
-// silently ignored.
-func checkResponse(r *http.Response) error {
-	if c := r.StatusCode; 200 <= c && c <= 299 {
-		return nil
-	}
-	errorResponse := &ErrorResponse{Response: r}
-	body, err := io.ReadAll(r.Body)
-	if err == nil && body != nil {
-		_ = json.Unmarshal(body, errorResponse)
+		wait, retry := c.RetryPolicy.retry(req, resp, err, attempt)
+		if !retry {
+			return resp, err
+		}
+		c.logRetry(ctx, req, attempt, wait)
+		if resp != nil {
+			// Drain the body so that the connection can be reused.
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+		if err := sleep(ctx, wait); err != nil {
+			return nil, err
+		}
 	}
-	return errorResponse
 }
+
+var errNilContext = errors.New("context must be non-nil")
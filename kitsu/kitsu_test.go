@@ -1,6 +1,8 @@
 package kitsu
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -119,6 +121,57 @@ func TestClient_NewRequest_encode(t *testing.T) {
 	}
 }
 
+func TestClient_NewRequest_validateIncludes(t *testing.T) {
+	c := NewClient(nil)
+	c.ValidateIncludes = true
+
+	tests := []struct {
+		url     string
+		include []string
+		ok      bool
+	}{
+		{defaultAPIVersion + "anime", []string{"animeStaff.person", "genres"}, true},
+		{defaultAPIVersion + "anime/1", []string{"animeCharacters.character"}, true},
+		{defaultAPIVersion + "library-entries", []string{"media.genres", "user"}, true},
+		{defaultAPIVersion + "anime/1/genres", []string{"anything"}, true}, // Not checked.
+		{defaultAPIVersion + "anime", []string{"animeStaff.persons"}, false},
+		{defaultAPIVersion + "anime", []string{"genres", "slug"}, false},
+		{defaultAPIVersion + "library-entries", []string{"media.chapters"}, false},
+		{defaultAPIVersion + "unknown", []string{"genres"}, true},   // No model, not checked.
+		{defaultAPIVersion + "categories", []string{"media"}, true}, // No model, not checked.
+		{defaultAPIVersion + "episodes/1", []string{"media"}, true}, // No model, not checked.
+	}
+	for _, tt := range tests {
+		_, err := c.NewRequest("GET", tt.url, nil, Include(tt.include...))
+		if tt.ok && err != nil {
+			t.Errorf("NewRequest(%q) with include %q returned err: %v", tt.url, tt.include, err)
+		}
+		if !tt.ok && err == nil {
+			t.Errorf("NewRequest(%q) with include %q expected to return err", tt.url, tt.include)
+		}
+	}
+
+	// Validation is opt-in.
+	c.ValidateIncludes = false
+	if _, err := c.NewRequest("GET", defaultAPIVersion+"anime", nil, Include("animeStaff.persons")); err != nil {
+		t.Errorf("NewRequest without validation returned err: %v", err)
+	}
+}
+
+func Test_kebabToCamel(t *testing.T) {
+	tests := []struct{ in, want string }{
+		{"anime", "anime"},
+		{"library-entries", "libraryEntries"},
+		{"anime-characters", "animeCharacters"},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		if got := kebabToCamel(tt.in); got != tt.want {
+			t.Errorf("kebabToCamel(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
 func TestClient_NewRequest_badURL(t *testing.T) {
 	c := NewClient(nil)
 	inURL := ":"
@@ -170,7 +223,7 @@ func TestClient_Do(t *testing.T) {
 
 	req, _ := client.NewRequest("GET", "/", nil)
 	got := new(foo)
-	_, err := client.Do(req, got)
+	_, err := client.Do(context.Background(), req, got)
 	if err != nil {
 		t.Fatalf("Do(%#v) returned err: %v", got, err)
 	}
@@ -181,6 +234,57 @@ func TestClient_Do(t *testing.T) {
 	}
 }
 
+func TestClient_Do_meta(t *testing.T) {
+	setup()
+	defer teardown()
+
+	type foo struct {
+		Bar string `jsonapi:"primary,foo"`
+	}
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data":{"id":"foobar","type":"foo"},"meta":{"count":"not a number","extra":true}}`)
+	})
+
+	req, _ := client.NewRequest("GET", "/", nil)
+	resp, err := client.Do(context.Background(), req, new(foo))
+	if err != nil {
+		t.Fatalf("Do returned err: %v", err)
+	}
+	if got, want := resp.Meta.Count, 0; got != want {
+		t.Errorf("Do response Meta.Count = %d, want %d", got, want)
+	}
+	if got, want := string(resp.Meta.Raw["extra"]), "true"; got != want {
+		t.Errorf("Do response Meta.Raw[extra] = %q, want %q", got, want)
+	}
+}
+
+func TestClient_Do_included(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{
+			"data":{"id":"1","type":"anime","relationships":{"genres":{"data":[{"type":"genres","id":"2"}]}}},
+			"included":[
+				{"id":"2","type":"genres","attributes":{"name":"Action"}},
+				{"id":"3","type":"people","attributes":{"name":"Kouichi Yamadera"}},
+				{"id":"4","type":"unknown"}
+			]
+		}`)
+	})
+
+	req, _ := client.NewRequest("GET", "/", nil)
+	resp, err := client.Do(context.Background(), req, new(Anime))
+	if err != nil {
+		t.Fatalf("Do returned err: %v", err)
+	}
+	want := []interface{}{&Person{ID: "3", Name: "Kouichi Yamadera"}}
+	if got := resp.Included; !reflect.DeepEqual(got, want) {
+		t.Errorf("Do response Included\nhave: %#v\nwant: %#v", got, want)
+	}
+}
+
 func TestClient_Do_badDecodeType(t *testing.T) {
 	setup()
 	defer teardown()
@@ -198,7 +302,7 @@ func TestClient_Do_badDecodeType(t *testing.T) {
 
 	req, _ := client.NewRequest("GET", "/", nil)
 	got := new(foo)
-	_, err := client.Do(req, got)
+	_, err := client.Do(context.Background(), req, got)
 	if err == nil {
 		t.Fatalf("Do with bad decode type expected to return err")
 	}
@@ -216,7 +320,7 @@ func TestClient_Do_noDecode(t *testing.T) {
 	})
 
 	req, _ := client.NewRequest("GET", "/", nil)
-	_, err := client.Do(req, nil)
+	_, err := client.Do(context.Background(), req, nil)
 	if err != nil {
 		t.Fatalf("Do(%v) returned err: %v", nil, err)
 	}
@@ -231,7 +335,7 @@ func TestClient_Do_httpError(t *testing.T) {
 	})
 
 	req, _ := client.NewRequest("GET", "/", nil)
-	_, err := client.Do(req, nil)
+	_, err := client.Do(context.Background(), req, nil)
 	if err == nil {
 		t.Error("Expected HTTP 400 error.")
 	}
@@ -246,7 +350,7 @@ func TestClient_Do_redirectLoop(t *testing.T) {
 	})
 
 	req, _ := client.NewRequest("GET", "/", nil)
-	_, err := client.Do(req, nil)
+	_, err := client.Do(context.Background(), req, nil)
 
 	if err == nil {
 		t.Error("Expected error to be returned.")
@@ -256,17 +360,31 @@ func TestClient_Do_redirectLoop(t *testing.T) {
 	}
 }
 
-func TestErrorResponse_Error(t *testing.T) {
-	resp := &http.Response{Request: &http.Request{}}
-	err := ErrorResponse{Response: resp}
-	if err.Error() == "" {
-		t.Errorf("Expected non-empty ErrorResponse.Error()")
+func TestClient_Do_nilContext(t *testing.T) {
+	c := NewClient(nil)
+	req, _ := c.NewRequest("GET", "/", nil)
+
+	var ctx context.Context
+	_, err := c.Do(ctx, req, nil)
+	if err == nil {
+		t.Error("Expected error to be returned for nil context.")
 	}
 }
 
-func TestError_Error(t *testing.T) {
-	err := Error{}
-	if err.Error() == "" {
-		t.Errorf("Expected non-empty Error.Error()")
+func TestClient_Do_canceledContext(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data":{"id":"foobar","type":"foo"}}`)
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	req, _ := client.NewRequest("GET", "/", nil)
+	_, err := client.Do(ctx, req, nil)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Do with canceled context returned err %v, want %v", err, context.Canceled)
 	}
 }
@@ -1,17 +1,26 @@
 package kitsu
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"iter"
+	"time"
+
+	"github.com/nstratos/go-kitsu/kitsu/internal/jsonapi"
 )
 
+// LibraryEntryStatus is the status of the media in a user's library.
+type LibraryEntryStatus string
+
 // The possible library entry statuses. They are convenient when creating a
 // LibraryEntry or for making comparisons with LibraryEntry.Status.
 const (
-	LibraryEntryStatusCurrent   = "current"
-	LibraryEntryStatusPlanned   = "planned"
-	LibraryEntryStatusCompleted = "completed"
-	LibraryEntryStatusOnHold    = "on_hold"
-	LibraryEntryStatusDropped   = "dropped"
+	LibraryEntryStatusCurrent   LibraryEntryStatus = "current"
+	LibraryEntryStatusPlanned   LibraryEntryStatus = "planned"
+	LibraryEntryStatusCompleted LibraryEntryStatus = "completed"
+	LibraryEntryStatusOnHold    LibraryEntryStatus = "on_hold"
+	LibraryEntryStatusDropped   LibraryEntryStatus = "dropped"
 )
 
 // LibraryService handles communication with the library entry related methods
@@ -20,26 +29,31 @@ type LibraryService service
 
 // LibraryEntry represents a Kitsu user's library entry.
 type LibraryEntry struct {
-	ID             string `jsonapi:"primary,libraryEntries"`
-	Status         string `jsonapi:"attr,status,omitempty"`         // Status for related media. Can be compared with LibraryEntryStatus constants.
-	Progress       int    `jsonapi:"attr,progress,omitempty"`       // How many episodes/chapters have been consumed, e.g. 22.
-	Reconsuming    bool   `jsonapi:"attr,reconsuming,omitempty"`    // Whether the media is being reconsumed, e.g. false.
-	ReconsumeCount int    `jsonapi:"attr,reconsumeCount,omitempty"` // How many times the media has been reconsumed, e.g. 0.
-	Notes          string `jsonapi:"attr,notes,omitempty"`          // Note attached to this entry, e.g. Very Interesting!
-	Private        bool   `jsonapi:"attr,private,omitempty"`        // Whether this entry is hidden from the public, e.g. false.
-	Rating         string `jsonapi:"attr,rating,omitempty"`         // User rating out of 5.0.
-	UpdatedAt      string `jsonapi:"attr,updatedAt,omitempty"`      // When the entry was last updated, e.g. 2016-11-12T03:35:00.064Z.
+	ID             string             `jsonapi:"primary,libraryEntries"`
+	Status         LibraryEntryStatus `jsonapi:"attr,status,omitempty"`         // Status for related media. Can be compared with LibraryEntryStatus constants.
+	Progress       int                `jsonapi:"attr,progress,omitempty"`       // How many episodes/chapters have been consumed, e.g. 22.
+	Reconsuming    bool               `jsonapi:"attr,reconsuming,omitempty"`    // Whether the media is being reconsumed, e.g. false.
+	ReconsumeCount int                `jsonapi:"attr,reconsumeCount,omitempty"` // How many times the media has been reconsumed, e.g. 0.
+	Notes          string             `jsonapi:"attr,notes,omitempty"`          // Note attached to this entry, e.g. Very Interesting!
+	Private        bool               `jsonapi:"attr,private,omitempty"`        // Whether this entry is hidden from the public, e.g. false.
+	Rating         string             `jsonapi:"attr,rating,omitempty"`         // User rating out of 5.0.
+	UpdatedAt      time.Time          `jsonapi:"attr,updatedAt,omitempty"`      // When the entry was last updated, e.g. 2016-11-12T03:35:00.064Z.
 
 	// Relationships.
 
-	User  *User       `jsonapi:"relation,user,omitempty"`
-	Anime *Anime      `jsonapi:"relation,anime,omitempty"`
-	Media interface{} `jsonapi:"relation,media,omitempty"`
+	User  *User  `jsonapi:"relation,user,omitempty"`
+	Anime *Anime `jsonapi:"relation,anime,omitempty"`
+	Manga *Manga `jsonapi:"relation,manga,omitempty"`
+	Drama *Drama `jsonapi:"relation,drama,omitempty"`
+
+	// Media is the anime, manga or drama of the entry. Its concrete type is
+	// one of *Anime, *Manga or *Drama. See MediaResource.
+	Media MediaResource `jsonapi:"relation,media,omitempty"`
 }
 
 // Show returns details for a specific LibraryEntry by providing a unique identifier
 // of the library entry, e.g. 5269457.
-func (s *LibraryService) Show(libraryEntryID string, opts ...URLOption) (*LibraryEntry, *Response, error) {
+func (s *LibraryService) Show(ctx context.Context, libraryEntryID string, opts ...URLOption) (*LibraryEntry, *Response, error) {
 	u := fmt.Sprintf(defaultAPIVersion+"library-entries/%s", libraryEntryID)
 
 	req, err := s.client.NewRequest("GET", u, nil, opts...)
@@ -48,7 +62,7 @@ func (s *LibraryService) Show(libraryEntryID string, opts ...URLOption) (*Librar
 	}
 
 	e := new(LibraryEntry)
-	resp, err := s.client.Do(req, e)
+	resp, err := s.client.Do(ctx, req, e)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -58,7 +72,7 @@ func (s *LibraryService) Show(libraryEntryID string, opts ...URLOption) (*Librar
 
 // List returns a list of Library entries. Optional parameters can be specified
 // to filter the search results and control pagination, sorting etc.
-func (s *LibraryService) List(opts ...URLOption) ([]*LibraryEntry, *Response, error) {
+func (s *LibraryService) List(ctx context.Context, opts ...URLOption) ([]*LibraryEntry, *Response, error) {
 	u := defaultAPIVersion + "library-entries"
 
 	req, err := s.client.NewRequest("GET", u, nil, opts...)
@@ -67,7 +81,7 @@ func (s *LibraryService) List(opts ...URLOption) ([]*LibraryEntry, *Response, er
 	}
 
 	var entries []*LibraryEntry
-	resp, err := s.client.Do(req, &entries)
+	resp, err := s.client.Do(ctx, req, &entries)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -76,7 +90,7 @@ func (s *LibraryService) List(opts ...URLOption) ([]*LibraryEntry, *Response, er
 }
 
 // Create creates a library entry. This method needs authentication.
-func (s *LibraryService) Create(e *LibraryEntry, opts ...URLOption) (*LibraryEntry, *Response, error) {
+func (s *LibraryService) Create(ctx context.Context, e *LibraryEntry, opts ...URLOption) (*LibraryEntry, *Response, error) {
 	u := defaultAPIVersion + "library-entries"
 
 	req, err := s.client.NewRequest("POST", u, e, opts...)
@@ -85,7 +99,45 @@ func (s *LibraryService) Create(e *LibraryEntry, opts ...URLOption) (*LibraryEnt
 	}
 
 	var entry = new(LibraryEntry)
-	resp, err := s.client.Do(req, entry)
+	resp, err := s.client.Do(ctx, req, entry)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return entry, resp, nil
+}
+
+// Update updates the library entry e, which must have its ID set, and returns
+// the updated entry. This method needs authentication.
+//
+// Only the attributes named in fields are sent, using their JSON API names
+// (e.g. "progress", "private", "notes"). They are sent even if they hold zero
+// values which allows, for example, to reset Progress to 0, set Private to
+// false or clear Notes:
+//
+//	e := &LibraryEntry{ID: "5269457", Progress: 0, Notes: ""}
+//	_, _, err := client.Library.Update(ctx, e, []string{"progress", "notes"})
+//
+// If fields is empty, all the attributes and relationships of e with non-zero
+// values are sent instead. An unknown attribute name results in an error.
+func (s *LibraryService) Update(ctx context.Context, e *LibraryEntry, fields []string, opts ...URLOption) (*LibraryEntry, *Response, error) {
+	if e == nil || e.ID == "" {
+		return nil, nil, errors.New("library entry to update must have an ID")
+	}
+	u := defaultAPIVersion + "library-entries/" + e.ID
+
+	var body interface{} = e
+	if len(fields) != 0 {
+		body = jsonapi.Fields(e, fields...)
+	}
+
+	req, err := s.client.NewRequest("PATCH", u, body, opts...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var entry = new(LibraryEntry)
+	resp, err := s.client.Do(ctx, req, entry)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -94,7 +146,7 @@ func (s *LibraryService) Create(e *LibraryEntry, opts ...URLOption) (*LibraryEnt
 }
 
 // Delete deletes a library entry. This method needs authentication.
-func (s *LibraryService) Delete(id string, opts ...URLOption) (*Response, error) {
+func (s *LibraryService) Delete(ctx context.Context, id string, opts ...URLOption) (*Response, error) {
 	u := defaultAPIVersion + "library-entries/" + id
 
 	req, err := s.client.NewRequest("DELETE", u, nil, opts...)
@@ -102,5 +154,22 @@ func (s *LibraryService) Delete(id string, opts ...URLOption) (*Response, error)
 		return nil, err
 	}
 
-	return s.client.Do(req, nil)
+	return s.client.Do(ctx, req, nil)
+}
+
+// GetMany returns the library entries with the given IDs using as few requests
+// as possible. The IDs are requested in chunks with the id filter and a few
+// chunks are requested concurrently.
+//
+// The library entries are returned in the order of ids with a nil in place of
+// each library entry that was not found. The IDs that were not found are also
+// returned. Optional parameters like Include apply to every chunk.
+func (s *LibraryService) GetMany(ctx context.Context, ids []string, opts ...URLOption) ([]*LibraryEntry, []string, error) {
+	return getMany(ctx, s.List, func(e *LibraryEntry) string { return e.ID }, ids, opts)
+}
+
+// All returns an iterator over all the Library entries that match opts; see
+// AnimeService.All.
+func (s *LibraryService) All(ctx context.Context, opts ...URLOption) iter.Seq2[*LibraryEntry, error] {
+	return all(ctx, s.List, opts)
 }
@@ -1,12 +1,15 @@
 package kitsu
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"reflect"
 	"testing"
+	"time"
 )
 
 func TestLibraryService_Show(t *testing.T) {
@@ -19,7 +22,7 @@ func TestLibraryService_Show(t *testing.T) {
 		fmt.Fprintf(w, `{"data":{"id":"5269457","type":"libraryEntries","attributes":{"status":"dropped"}}}`)
 	})
 
-	got, _, err := client.Library.Show("5269457")
+	got, _, err := client.Library.Show(context.Background(), "5269457")
 	if err != nil {
 		t.Errorf("Library.Show returned error: %v", err)
 	}
@@ -84,7 +87,7 @@ func TestLibraryService_Show_decodeAttributes(t *testing.T) {
 		}`)
 	})
 
-	got, _, err := client.Library.Show("5269457", Include("user"))
+	got, _, err := client.Library.Show(context.Background(), "5269457", Include("user"))
 	if err != nil {
 		t.Fatalf("Library.Show returned error: %v", err)
 	}
@@ -97,7 +100,7 @@ func TestLibraryService_Show_decodeAttributes(t *testing.T) {
 		ReconsumeCount: 0,
 		Notes:          "",
 		Private:        false,
-		UpdatedAt:      "2014-05-14T11:54:26.310Z",
+		UpdatedAt:      time.Date(2014, 5, 14, 11, 54, 26, 310e6, time.UTC),
 		Rating:         "0.0",
 		User: &User{
 			ID:   "43133",
@@ -123,7 +126,7 @@ func TestLibraryService_Show_notFound(t *testing.T) {
 		http.Error(w, `{"errors":[{"title":"Record not found","detail":"The record identified by 0 could not be found.","code":"404","status":"404"}]}`, http.StatusNotFound)
 	})
 
-	_, resp, err := client.Library.Show("0")
+	_, resp, err := client.Library.Show(context.Background(), "0")
 	if err == nil {
 		t.Error("Expected HTTP 404 error.")
 	}
@@ -134,7 +137,7 @@ func TestLibraryService_Show_notFound(t *testing.T) {
 }
 
 func TestLibraryService_Show_invalidID(t *testing.T) {
-	_, _, err := client.Library.Show("%", nil)
+	_, _, err := client.Library.Show(context.Background(), "%", nil)
 	if err == nil {
 		t.Errorf("Expected error to be returned")
 	}
@@ -222,6 +225,7 @@ func TestLibraryService_List(t *testing.T) {
 	})
 
 	got, resp, err := client.Library.List(
+		context.Background(),
 		Filter("userId", "5554"),
 	)
 	if err != nil {
@@ -237,7 +241,7 @@ func TestLibraryService_List(t *testing.T) {
 			ReconsumeCount: 0,
 			Notes:          "",
 			Private:        false,
-			UpdatedAt:      "2016-09-06T06:23:05.771Z",
+			UpdatedAt:      time.Date(2016, 9, 6, 6, 23, 5, 771e6, time.UTC),
 			Rating:         "3.5",
 		},
 		{
@@ -248,7 +252,7 @@ func TestLibraryService_List(t *testing.T) {
 			ReconsumeCount: 0,
 			Notes:          "you should watch it",
 			Private:        false,
-			UpdatedAt:      "2016-04-14T00:56:32.652Z",
+			UpdatedAt:      time.Date(2016, 4, 14, 0, 56, 32, 652e6, time.UTC),
 			Rating:         "5.0",
 		},
 	}
@@ -263,6 +267,16 @@ func TestLibraryService_List(t *testing.T) {
 	if got, want := resp.Offset, offset; got != want {
 		t.Errorf("Library.List response Offset = %+v, want %+v", got, want)
 	}
+	if got, want := resp.Meta.Count, 273; got != want {
+		t.Errorf("Library.List response Meta.Count = %d, want %d", got, want)
+	}
+	var statusCounts map[string]int
+	if err := json.Unmarshal(resp.Meta.Raw["statusCounts"], &statusCounts); err != nil {
+		t.Fatalf("Library.List response Meta.Raw[statusCounts] decode returned error: %v", err)
+	}
+	if got, want := statusCounts["completed"], 132; got != want {
+		t.Errorf("Library.List response statusCounts[completed] = %d, want %d", got, want)
+	}
 }
 
 func TestLibraryService_List_filterOptionWithUnknownAttribute(t *testing.T) {
@@ -281,7 +295,7 @@ func TestLibraryService_List_filterOptionWithUnknownAttribute(t *testing.T) {
 		fmt.Fprint(w, s)
 	})
 
-	_, _, err := client.Library.List(Filter("unknown_attribute", "unknown_value"))
+	_, _, err := client.Library.List(context.Background(), Filter("unknown_attribute", "unknown_value"))
 	if err == nil {
 		t.Fatal("Library.List with unknown filter expected to return err")
 	}
@@ -346,7 +360,7 @@ func TestLibraryService_Create(t *testing.T) {
 		},
 	}
 
-	got, _, err := client.Library.Create(newEntry) //kitsu.Include("anime", "user"),
+	got, _, err := client.Library.Create(context.Background(), newEntry) //kitsu.Include("anime", "user"),
 	if err != nil {
 		t.Fatal("could not create library:", err)
 	}
@@ -356,7 +370,7 @@ func TestLibraryService_Create(t *testing.T) {
 		Status:    LibraryEntryStatusCurrent,
 		Progress:  4,
 		Rating:    "0.5",
-		UpdatedAt: "2018-02-19T17:44:36.911Z",
+		UpdatedAt: time.Date(2018, 2, 19, 17, 44, 36, 911e6, time.UTC),
 	}
 	deepEqual(t, got, want, "create library return mismatch")
 }
@@ -371,6 +385,46 @@ func deepEqual(t *testing.T, got, want interface{}, message string) {
 	}
 }
 
+func TestLibraryService_Update(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/"+defaultAPIVersion+"library-entries/5269457", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testHeader(t, r, "Accept", defaultMediaType)
+		testHeader(t, r, "Content-Type", defaultMediaType)
+
+		body, _ := io.ReadAll(r.Body)
+		want := `{"data":{"type":"libraryEntries","id":"5269457","attributes":{"notes":"","private":false,"progress":0}}}` + "\n"
+		if got := string(body); got != want {
+			t.Errorf("Library.Update request body\nhave: %q\nwant: %q", got, want)
+		}
+
+		fmt.Fprint(w, `{"data":{"id":"5269457","type":"libraryEntries","attributes":{"status":"current","progress":0,"notes":null,"private":false}}}`)
+	})
+
+	e := &LibraryEntry{ID: "5269457", Status: LibraryEntryStatusDropped, Progress: 0}
+	got, _, err := client.Library.Update(context.Background(), e, []string{"progress", "private", "notes"})
+	if err != nil {
+		t.Fatalf("Library.Update returned error: %v", err)
+	}
+
+	want := &LibraryEntry{ID: "5269457", Status: LibraryEntryStatusCurrent}
+	deepEqual(t, got, want, "Library.Update return mismatch")
+}
+
+func TestLibraryService_Update_badArguments(t *testing.T) {
+	c := NewClient(nil)
+	ctx := context.Background()
+
+	if _, _, err := c.Library.Update(ctx, &LibraryEntry{}, []string{"progress"}); err == nil {
+		t.Error("Library.Update without ID expected to return err")
+	}
+	if _, _, err := c.Library.Update(ctx, &LibraryEntry{ID: "1"}, []string{"progres"}); err == nil {
+		t.Error("Library.Update with unknown field expected to return err")
+	}
+}
+
 func TestLibraryService_Delete(t *testing.T) {
 	setup()
 	defer teardown()
@@ -381,7 +435,7 @@ func TestLibraryService_Delete(t *testing.T) {
 		w.WriteHeader(202)
 	})
 
-	resp, err := client.Library.Delete("1644")
+	resp, err := client.Library.Delete(context.Background(), "1644")
 	if err != nil {
 		t.Errorf("Library.Delete returned error: %v", err)
 	}
@@ -403,7 +457,7 @@ func TestLibraryService_Delete_404(t *testing.T) {
 		json.NewEncoder(w).Encode(&struct{ Errors []Error }{[]Error{{Title: "Record not found", Code: "404", Status: "404"}}})
 	})
 
-	resp, err := client.Library.Delete("1644")
+	resp, err := client.Library.Delete(context.Background(), "1644")
 	if err == nil {
 		t.Error("Library.Delete for 404 expected to return error")
 	}
@@ -416,3 +470,70 @@ func TestLibraryService_Delete_404(t *testing.T) {
 		t.Errorf("Library.Delete response code = %d, want %d", got, want)
 	}
 }
+
+func TestLibraryService_List_media(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/"+defaultAPIVersion+"library-entries", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"filter[userId]": "5554",
+			"include":        "media",
+		})
+
+		const s = `
+		{
+		   "data":[
+		      {
+		         "id":"1",
+		         "type":"libraryEntries",
+		         "attributes":{"status":"current","progress":3},
+		         "relationships":{"media":{"data":{"type":"anime","id":"7442"}}}
+		      },
+		      {
+		         "id":"2",
+		         "type":"libraryEntries",
+		         "attributes":{"status":"planned"},
+		         "relationships":{"media":{"data":{"type":"manga","id":"14"}}}
+		      },
+		      {
+		         "id":"3",
+		         "type":"libraryEntries",
+		         "attributes":{"status":"dropped"},
+		         "relationships":{"media":{"data":{"type":"dramas","id":"5"}}}
+		      }
+		   ],
+		   "included":[
+		      {"id":"7442","type":"anime","attributes":{"canonicalTitle":"Attack on Titan","episodeCount":25}},
+		      {"id":"14","type":"manga","attributes":{"canonicalTitle":"Monster","chapterCount":162}}
+		   ]
+		}`
+		fmt.Fprint(w, s)
+	})
+
+	got, _, err := client.Library.List(context.Background(), Filter("userId", "5554"), Include("media"))
+	if err != nil {
+		t.Fatalf("Library.List returned err: %v", err)
+	}
+
+	want := []*LibraryEntry{
+		{
+			ID:       "1",
+			Status:   LibraryEntryStatusCurrent,
+			Progress: 3,
+			Media:    &Anime{ID: "7442", Media: Media{CanonicalTitle: "Attack on Titan"}, EpisodeCount: 25},
+		},
+		{
+			ID:     "2",
+			Status: LibraryEntryStatusPlanned,
+			Media:  &Manga{ID: "14", Media: Media{CanonicalTitle: "Monster"}, ChapterCount: 162},
+		},
+		{
+			ID:     "3",
+			Status: LibraryEntryStatusDropped,
+			Media:  &Drama{ID: "5"},
+		},
+	}
+	deepEqual(t, got, want, "Library.List media mismatch")
+}
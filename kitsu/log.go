@@ -0,0 +1,100 @@
+package kitsu
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httputil"
+	"time"
+)
+
+// logger returns the logger of the client or, when built with -tags=debug,
+// the logger that writes to stdout. It returns nil if logging is disabled.
+func (c *Client) logger() *slog.Logger {
+	if c.Logger != nil {
+		return c.Logger
+	}
+	return debugLogger
+}
+
+// logBodies reports whether the bodies of requests and responses are logged.
+func (c *Client) logBodies() bool {
+	return c.LogBodies || debugBodies
+}
+
+// logRequest logs req before it is sent for the given attempt.
+func (c *Client) logRequest(ctx context.Context, req *http.Request, attempt int) {
+	l := c.logger()
+	if l == nil || !l.Enabled(ctx, slog.LevelDebug) {
+		return
+	}
+	attrs := []slog.Attr{
+		slog.String("method", req.Method),
+		slog.String("url", req.URL.String()),
+		slog.Int("attempt", attempt),
+	}
+	if c.logBodies() {
+		attrs = append(attrs, dumpAttr(dumpRequest(req)))
+	}
+	l.LogAttrs(ctx, slog.LevelDebug, "kitsu: sending request", attrs...)
+}
+
+// logResponse logs the response, or the error, received for req after
+// latency.
+func (c *Client) logResponse(ctx context.Context, req *http.Request, resp *http.Response, err error, attempt int, latency time.Duration) {
+	l := c.logger()
+	if l == nil || !l.Enabled(ctx, slog.LevelDebug) {
+		return
+	}
+	attrs := []slog.Attr{
+		slog.String("method", req.Method),
+		slog.String("url", req.URL.String()),
+		slog.Int("attempt", attempt),
+		slog.Duration("latency", latency),
+	}
+	if err != nil {
+		attrs = append(attrs, slog.Any("error", err))
+		l.LogAttrs(ctx, slog.LevelDebug, "kitsu: request failed", attrs...)
+		return
+	}
+	attrs = append(attrs, slog.Int("status", resp.StatusCode))
+	if c.logBodies() {
+		attrs = append(attrs, dumpAttr(httputil.DumpResponse(resp, true)))
+	}
+	l.LogAttrs(ctx, slog.LevelDebug, "kitsu: received response", attrs...)
+}
+
+// logRetry logs that req is retried after wait.
+func (c *Client) logRetry(ctx context.Context, req *http.Request, attempt int, wait time.Duration) {
+	l := c.logger()
+	if l == nil || !l.Enabled(ctx, slog.LevelDebug) {
+		return
+	}
+	l.LogAttrs(ctx, slog.LevelDebug, "kitsu: retrying request",
+		slog.String("method", req.Method),
+		slog.String("url", req.URL.String()),
+		slog.Int("attempt", attempt),
+		slog.Duration("wait", wait),
+	)
+}
+
+// dumpRequest dumps req including its body, with the value of the
+// Authorization header redacted. The body of req remains readable.
+func dumpRequest(req *http.Request) ([]byte, error) {
+	if req.Header.Get("Authorization") == "" {
+		return httputil.DumpRequest(req, true)
+	}
+	header := req.Header
+	defer func() { req.Header = header }()
+	req.Header = header.Clone()
+	req.Header.Set("Authorization", "REDACTED")
+	return httputil.DumpRequest(req, true)
+}
+
+// dumpAttr returns the dump as an attribute or the error if dumping failed.
+func dumpAttr(dump []byte, err error) slog.Attr {
+	if err != nil {
+		return slog.String("dump_error", err.Error())
+	}
+	return slog.String("dump", string(dump))
+}
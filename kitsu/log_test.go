@@ -0,0 +1,138 @@
+package kitsu
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// testLogger returns a debug level logger that writes JSON records to a
+// buffer and a function that decodes the records written so far.
+func testLogger(t *testing.T) (*slog.Logger, func() []map[string]interface{}) {
+	t.Helper()
+	var buf bytes.Buffer
+	l := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	return l, func() []map[string]interface{} {
+		var records []map[string]interface{}
+		dec := json.NewDecoder(&buf)
+		for {
+			var r map[string]interface{}
+			if err := dec.Decode(&r); err == io.EOF {
+				break
+			} else if err != nil {
+				t.Fatalf("decoding log record: %v", err)
+			}
+			records = append(records, r)
+		}
+		return records
+	}
+}
+
+func TestClient_Logger(t *testing.T) {
+	setup()
+	defer teardown()
+	l, records := testLogger(t)
+	client.Logger = l
+	client.RetryPolicy = testRetryPolicy
+
+	var hits int
+	mux.HandleFunc("/"+defaultAPIVersion+"anime/1", func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		if hits == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, `{"data":{"id":"1","type":"anime"}}`)
+	})
+
+	if _, _, err := client.Anime.Show(context.Background(), "1"); err != nil {
+		t.Fatalf("Anime.Show returned err: %v", err)
+	}
+
+	url := client.BaseURL.String() + "/" + defaultAPIVersion + "anime/1"
+	want := []struct {
+		msg     string
+		attempt float64
+		status  float64
+	}{
+		{"kitsu: sending request", 1, 0},
+		{"kitsu: received response", 1, http.StatusServiceUnavailable},
+		{"kitsu: retrying request", 1, 0},
+		{"kitsu: sending request", 2, 0},
+		{"kitsu: received response", 2, http.StatusOK},
+	}
+	got := records()
+	if len(got) != len(want) {
+		t.Fatalf("logged %d records, want %d: %v", len(got), len(want), got)
+	}
+	for i, r := range got {
+		w := want[i]
+		if r["level"] != "DEBUG" || r["msg"] != w.msg || r["method"] != "GET" || r["url"] != url || r["attempt"] != w.attempt {
+			t.Errorf("record %d = %v, want msg %q, attempt %v", i, r, w.msg, w.attempt)
+		}
+		if w.status != 0 {
+			if r["status"] != w.status {
+				t.Errorf("record %d status = %v, want %v", i, r["status"], w.status)
+			}
+			if _, ok := r["latency"]; !ok {
+				t.Errorf("record %d has no latency", i)
+			}
+		}
+		if _, ok := r["dump"]; ok && !debugBodies {
+			t.Errorf("record %d has a dump without LogBodies", i)
+		}
+	}
+}
+
+func TestClient_LogBodies(t *testing.T) {
+	setup()
+	defer teardown()
+	l, records := testLogger(t)
+	client.Logger = l
+	client.LogBodies = true
+	client.Use(func(next Handler) Handler {
+		return func(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
+			req.Header.Set("Authorization", "Bearer secret")
+			return next(ctx, req, v)
+		}
+	})
+
+	mux.HandleFunc("/"+defaultAPIVersion+"library-entries/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testHeader(t, r, "Authorization", "Bearer secret")
+		body, _ := io.ReadAll(r.Body)
+		if !strings.Contains(string(body), `"progress":2`) {
+			t.Errorf("request body = %s, want progress", body)
+		}
+		fmt.Fprint(w, `{"data":{"id":"1","type":"libraryEntries","attributes":{"progress":2}}}`)
+	})
+
+	e, _, err := client.Library.Update(context.Background(), &LibraryEntry{ID: "1", Progress: 2}, []string{"progress"})
+	if err != nil {
+		t.Fatalf("Library.Update returned err: %v", err)
+	}
+	if got, want := e.Progress, 2; got != want {
+		t.Errorf("Library.Update progress = %d, want %d", got, want)
+	}
+
+	got := records()
+	if len(got) != 2 {
+		t.Fatalf("logged %d records, want 2: %v", len(got), got)
+	}
+	req, _ := got[0]["dump"].(string)
+	if !strings.Contains(req, "Authorization: REDACTED") || strings.Contains(req, "secret") {
+		t.Errorf("request dump does not redact Authorization:\n%s", req)
+	}
+	if !strings.Contains(req, `"progress":2`) {
+		t.Errorf("request dump has no body:\n%s", req)
+	}
+	if resp, _ := got[1]["dump"].(string); !strings.Contains(resp, `"type":"libraryEntries"`) {
+		t.Errorf("response dump has no body:\n%s", resp)
+	}
+}
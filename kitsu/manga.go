@@ -1,11 +1,123 @@
 package kitsu
 
+import (
+	"context"
+	"fmt"
+	"iter"
+)
+
+// MangaType is the show type of a Manga.
+type MangaType string
+
 // The possible manga show types. They are convenient for making comparisons
-// with Manga.ShowType.
+// with Manga.MangaType and Manga.Subtype.
+const (
+	MangaTypeDrama   MangaType = "drama"
+	MangaTypeNovel   MangaType = "novel"
+	MangaTypeManhua  MangaType = "manhua"
+	MangaTypeOneshot MangaType = "oneshot"
+	MangaTypeDoujin  MangaType = "doujin"
+	MangaTypeManga   MangaType = "manga"
+	MangaTypeManhwa  MangaType = "manhwa"
+	MangaTypeOEL     MangaType = "oel"
+)
+
+// MangaStatus is the publishing status of a Manga.
+type MangaStatus string
+
+// Possible values for Manga.Status.
 const (
-	MangaTypeDrama   = "drama"
-	MangaTypeNovel   = "novel"
-	MangaTypeManhua  = "manhua"
-	MangaTypeOneshot = "oneshot"
-	MangaTypeDoujin  = "doujin"
+	MangaStatusCurrent    MangaStatus = "current"
+	MangaStatusFinished   MangaStatus = "finished"
+	MangaStatusTBA        MangaStatus = "tba"
+	MangaStatusUnreleased MangaStatus = "unreleased"
+	MangaStatusUpcoming   MangaStatus = "upcoming"
 )
+
+// MangaService handles communication with the manga related methods of the
+// Kitsu API.
+//
+// Kitsu API docs:
+// http://docs.kitsu.apiary.io/#reference/media/manga
+type MangaService service
+
+// Manga represents a Kitsu manga.
+//
+// Additional filters: text
+type Manga struct {
+	ID string `jsonapi:"primary,manga"`
+
+	// Media holds the attributes and relationships common to all media
+	// types.
+	Media
+
+	// --- Attributes ---
+
+	// Show format of the manga. Possible values described by the MangaType
+	// constants.
+	Subtype MangaType `jsonapi:"attr,subtype,omitempty"`
+
+	// Possible values described by the MangaStatus constants.
+	Status MangaStatus `jsonapi:"attr,status,omitempty"`
+
+	// How many chapters the manga has, e.g. 162
+	ChapterCount int `jsonapi:"attr,chapterCount,omitempty"`
+
+	// How many volumes the manga has, e.g. 18
+	VolumeCount int `jsonapi:"attr,volumeCount,omitempty"`
+
+	// The magazine the manga was serialized in, e.g. Big Comic Original
+	Serialization string `jsonapi:"attr,serialization,omitempty"`
+
+	// Possible values described by the MangaType constants.
+	MangaType MangaType `jsonapi:"attr,mangaType,omitempty"`
+
+	// --- Relationships ---
+
+	Staff      []*MangaStaff     `jsonapi:"relation,mangaStaff,omitempty"`
+	Characters []*MangaCharacter `jsonapi:"relation,mangaCharacters,omitempty"`
+}
+
+// Show returns details for a specific Manga by providing a unique identifier
+// of the manga e.g. 14.
+func (s *MangaService) Show(ctx context.Context, mangaID string, opts ...URLOption) (*Manga, *Response, error) {
+	u := fmt.Sprintf(defaultAPIVersion+"manga/%s", mangaID)
+
+	req, err := s.client.NewRequest("GET", u, nil, opts...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	m := new(Manga)
+	resp, err := s.client.Do(ctx, req, m)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return m, resp, nil
+}
+
+// List returns a list of Manga. Optional parameters can be specified to filter
+// the search results and control pagination, sorting etc.
+func (s *MangaService) List(ctx context.Context, opts ...URLOption) ([]*Manga, *Response, error) {
+	u := defaultAPIVersion + "manga"
+
+	req, err := s.client.NewRequest("GET", u, nil, opts...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var manga []*Manga
+	resp, err := s.client.Do(ctx, req, &manga)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return manga, resp, nil
+}
+
+// All returns an iterator over all the Manga that match opts; see
+// AnimeService.All.
+func (s *MangaService) All(ctx context.Context, opts ...URLOption) iter.Seq2[*Manga, error] {
+	return all(ctx, s.List, opts)
+}
@@ -0,0 +1,72 @@
+package kitsu
+
+import (
+	"context"
+	"fmt"
+	"iter"
+)
+
+// MangaCharacterService handles communication with the manga character related
+// methods of the Kitsu API.
+//
+// Kitsu API docs:
+// http://docs.kitsu.apiary.io/#reference/characters-&-people/manga-characters
+type MangaCharacterService service
+
+// MangaCharacter represents the characters of a Manga entry.
+//
+// Additional filters: mangaId
+type MangaCharacter struct {
+	ID   string `jsonapi:"primary,mangaCharacters"`
+	Role string `jsonapi:"attr,role"`
+
+	Manga     *Manga     `jsonapi:"relation,manga,omitempty"`
+	Character *Character `jsonapi:"relation,character,omitempty"`
+}
+
+// Show returns details for a specific MangaCharacter by providing a unique
+// identifier of the manga character e.g. 1.
+func (s *MangaCharacterService) Show(ctx context.Context, mangaCharacterID string, opts ...URLOption) (*MangaCharacter, *Response, error) {
+	u := fmt.Sprintf(defaultAPIVersion+"manga-characters/%s", mangaCharacterID)
+
+	req, err := s.client.NewRequest("GET", u, nil, opts...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := new(MangaCharacter)
+	resp, err := s.client.Do(ctx, req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return v, resp, nil
+}
+
+// List returns a list of MangaCharacter. Optional parameters can be specified
+// to filter the results and control pagination, sorting etc. The mangaId
+// filter returns the characters of a single manga:
+//
+//	client.MangaCharacter.List(ctx, kitsu.Filter("mangaId", "1"), kitsu.Limit(20))
+func (s *MangaCharacterService) List(ctx context.Context, opts ...URLOption) ([]*MangaCharacter, *Response, error) {
+	u := defaultAPIVersion + "manga-characters"
+
+	req, err := s.client.NewRequest("GET", u, nil, opts...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var characters []*MangaCharacter
+	resp, err := s.client.Do(ctx, req, &characters)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return characters, resp, nil
+}
+
+// All returns an iterator over all the MangaCharacter that match opts; see
+// AnimeService.All.
+func (s *MangaCharacterService) All(ctx context.Context, opts ...URLOption) iter.Seq2[*MangaCharacter, error] {
+	return all(ctx, s.List, opts)
+}
@@ -0,0 +1,104 @@
+package kitsu
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestMangaCharacterService_Show(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/"+defaultAPIVersion+"manga-characters/3", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", defaultMediaType)
+		testFormValues(t, r, values{"include": "character"})
+		fmt.Fprint(w, `{
+			"data":{
+				"id":"3",
+				"type":"mangaCharacters",
+				"attributes":{"role":"main"},
+				"relationships":{"character":{"data":{"type":"characters","id":"1"}}}
+			},
+			"included":[{"id":"1","type":"characters","attributes":{"name":"Kenzou Tenma"}}]
+		}`)
+	})
+
+	got, _, err := client.MangaCharacter.Show(context.Background(), "3", Include("character"))
+	if err != nil {
+		t.Fatalf("MangaCharacter.Show returned error: %v", err)
+	}
+
+	want := &MangaCharacter{ID: "3", Role: "main", Character: &Character{ID: "1", Name: "Kenzou Tenma"}}
+	deepEqual(t, got, want, "MangaCharacter.Show mismatch")
+}
+
+func TestMangaCharacterService_List(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/"+defaultAPIVersion+"manga-characters", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", defaultMediaType)
+		testFormValues(t, r, values{
+			"filter[mangaId]": "1",
+			"include":         "character",
+			"page[limit]":     "2",
+			"page[offset]":    "2",
+		})
+		fmt.Fprint(w, `{
+			"data":[
+				{
+					"id":"4",
+					"type":"mangaCharacters",
+					"attributes":{"role":"main"},
+					"relationships":{"character":{"data":{"type":"characters","id":"2"}}}
+				},
+				{
+					"id":"5",
+					"type":"mangaCharacters",
+					"attributes":{"role":"supporting"},
+					"relationships":{"character":{"data":{"type":"characters","id":"3"}}}
+				}
+			],
+			"included":[
+				{"id":"2","type":"characters","attributes":{"name":"Johan Liebert"}},
+				{"id":"3","type":"characters","attributes":{"name":"Nina Fortner"}}
+			],
+			"meta":{"count":62},
+			"links":{
+				"first":"https://kitsu.io/api/edge/manga-characters?filter%5BmangaId%5D=1&page%5Blimit%5D=2&page%5Boffset%5D=0",
+				"prev":"https://kitsu.io/api/edge/manga-characters?filter%5BmangaId%5D=1&page%5Blimit%5D=2&page%5Boffset%5D=0",
+				"next":"https://kitsu.io/api/edge/manga-characters?filter%5BmangaId%5D=1&page%5Blimit%5D=2&page%5Boffset%5D=4",
+				"last":"https://kitsu.io/api/edge/manga-characters?filter%5BmangaId%5D=1&page%5Blimit%5D=2&page%5Boffset%5D=60"
+			}
+		}`)
+	})
+
+	got, resp, err := client.MangaCharacter.List(context.Background(),
+		Filter("mangaId", "1"),
+		Include("character"),
+		Pagination(2, 2),
+	)
+	if err != nil {
+		t.Fatalf("MangaCharacter.List returned error: %v", err)
+	}
+
+	want := []*MangaCharacter{
+		{ID: "4", Role: "main", Character: &Character{ID: "2", Name: "Johan Liebert"}},
+		{ID: "5", Role: "supporting", Character: &Character{ID: "3", Name: "Nina Fortner"}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("MangaCharacter.List mismatch\nhave: %#+v\nwant: %#+v", got, want)
+	}
+	offset := PageOffset{First: 0, Prev: 0, Next: 4, Last: 60}
+	if got, want := resp.Offset, offset; got != want {
+		t.Errorf("MangaCharacter.List response Offset = %+v, want %+v", got, want)
+	}
+	if got, want := resp.Meta.Count, 62; got != want {
+		t.Errorf("MangaCharacter.List response Meta.Count = %d, want %d", got, want)
+	}
+}
@@ -0,0 +1,76 @@
+package kitsu
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"time"
+)
+
+// MangaStaffService handles communication with the manga staff related methods
+// of the Kitsu API.
+//
+// Kitsu API docs:
+// http://docs.kitsu.apiary.io/#reference/characters-&-people/manga-staff
+type MangaStaffService service
+
+// MangaStaff represents the staff of a Manga entry like its authors and
+// artists.
+//
+// Additional filters: mangaId
+type MangaStaff struct {
+	ID        string    `jsonapi:"primary,mangaStaff"`
+	Role      string    `jsonapi:"attr,role,omitempty"`
+	CreatedAt time.Time `jsonapi:"attr,createdAt,omitempty"` // Date and time of creation, e.g. 2017-07-27T22:21:26.824Z
+	UpdatedAt time.Time `jsonapi:"attr,updatedAt,omitempty"` // Date and time of last modification, e.g. 2017-07-27T22:47:45.129Z
+
+	Manga  *Manga  `jsonapi:"relation,manga,omitempty"`
+	Person *Person `jsonapi:"relation,person,omitempty"`
+}
+
+// Show returns details for a specific MangaStaff by providing a unique
+// identifier of the manga staff e.g. 1.
+func (s *MangaStaffService) Show(ctx context.Context, mangaStaffID string, opts ...URLOption) (*MangaStaff, *Response, error) {
+	u := fmt.Sprintf(defaultAPIVersion+"manga-staff/%s", mangaStaffID)
+
+	req, err := s.client.NewRequest("GET", u, nil, opts...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := new(MangaStaff)
+	resp, err := s.client.Do(ctx, req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return v, resp, nil
+}
+
+// List returns a list of MangaStaff. Optional parameters can be specified
+// to filter the results and control pagination, sorting etc. The mangaId
+// filter returns the staff of a single manga:
+//
+//	client.MangaStaff.List(ctx, kitsu.Filter("mangaId", "1"), kitsu.Limit(20))
+func (s *MangaStaffService) List(ctx context.Context, opts ...URLOption) ([]*MangaStaff, *Response, error) {
+	u := defaultAPIVersion + "manga-staff"
+
+	req, err := s.client.NewRequest("GET", u, nil, opts...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var staff []*MangaStaff
+	resp, err := s.client.Do(ctx, req, &staff)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return staff, resp, nil
+}
+
+// All returns an iterator over all the MangaStaff that match opts; see
+// AnimeService.All.
+func (s *MangaStaffService) All(ctx context.Context, opts ...URLOption) iter.Seq2[*MangaStaff, error] {
+	return all(ctx, s.List, opts)
+}
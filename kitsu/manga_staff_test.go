@@ -0,0 +1,80 @@
+package kitsu
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestMangaStaffService_Show(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/"+defaultAPIVersion+"manga-staff/5", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", defaultMediaType)
+		testFormValues(t, r, values{"include": "person"})
+		fmt.Fprint(w, `{
+			"data":{
+				"id":"5",
+				"type":"mangaStaff",
+				"attributes":{"role":"Story & Art"},
+				"relationships":{"person":{"data":{"type":"people","id":"9"}}}
+			},
+			"included":[{"id":"9","type":"people","attributes":{"name":"Naoki Urasawa"}}]
+		}`)
+	})
+
+	got, _, err := client.MangaStaff.Show(context.Background(), "5", Include("person"))
+	if err != nil {
+		t.Fatalf("MangaStaff.Show returned error: %v", err)
+	}
+
+	want := &MangaStaff{ID: "5", Role: "Story & Art", Person: &Person{ID: "9", Name: "Naoki Urasawa"}}
+	deepEqual(t, got, want, "MangaStaff.Show mismatch")
+}
+
+func TestMangaStaffService_List(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/"+defaultAPIVersion+"manga-staff", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", defaultMediaType)
+		testFormValues(t, r, values{
+			"filter[mangaId]": "1",
+			"page[limit]":     "2",
+			"page[offset]":    "0",
+		})
+		fmt.Fprint(w, `{
+			"data":[
+				{"id":"5","type":"mangaStaff","attributes":{"role":"Story & Art"}},
+				{"id":"6","type":"mangaStaff","attributes":{"role":"Story"}}
+			],
+			"links":{
+				"first":"https://kitsu.io/api/edge/manga-staff?filter%5BmangaId%5D=1&page%5Blimit%5D=2&page%5Boffset%5D=0",
+				"next":"https://kitsu.io/api/edge/manga-staff?filter%5BmangaId%5D=1&page%5Blimit%5D=2&page%5Boffset%5D=2",
+				"last":"https://kitsu.io/api/edge/manga-staff?filter%5BmangaId%5D=1&page%5Blimit%5D=2&page%5Boffset%5D=12"
+			}
+		}`)
+	})
+
+	got, resp, err := client.MangaStaff.List(context.Background(), Filter("mangaId", "1"), Pagination(2, 0))
+	if err != nil {
+		t.Fatalf("MangaStaff.List returned error: %v", err)
+	}
+
+	want := []*MangaStaff{
+		{ID: "5", Role: "Story & Art"},
+		{ID: "6", Role: "Story"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("MangaStaff.List mismatch\nhave: %#+v\nwant: %#+v", got, want)
+	}
+	offset := PageOffset{First: 0, Next: 2, Last: 12}
+	if got, want := resp.Offset, offset; got != want {
+		t.Errorf("MangaStaff.List response Offset = %+v, want %+v", got, want)
+	}
+}
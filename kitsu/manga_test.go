@@ -0,0 +1,143 @@
+package kitsu
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestMangaService_Show(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/"+defaultAPIVersion+"manga/14", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", defaultMediaType)
+		testFormValues(t, r, values{
+			"include": "genres,mangaStaff.person",
+		})
+		fmt.Fprint(w, `{
+			"data":{
+				"id":"14",
+				"type":"manga",
+				"attributes":{
+					"slug":"monster",
+					"canonicalTitle":"Monster",
+					"chapterCount":162,
+					"volumeCount":18,
+					"serialization":"Big Comic Original",
+					"mangaType":"manga",
+					"subtype":"manga",
+					"status":"finished"
+				},
+				"relationships":{
+					"genres":{
+						"data":[{"type":"genres","id":"7"}]
+					},
+					"mangaStaff":{
+						"data":[{"type":"mangaStaff","id":"3"}]
+					}
+				}
+			},
+			"included":[
+				{"id":"7","type":"genres","attributes":{"name":"Mystery","slug":"mystery"}},
+				{
+					"id":"3",
+					"type":"mangaStaff",
+					"attributes":{"role":"Story & Art"},
+					"relationships":{"person":{"data":{"type":"people","id":"9"}}}
+				},
+				{"id":"9","type":"people","attributes":{"name":"Naoki Urasawa"}}
+			]
+		}`)
+	})
+
+	got, _, err := client.Manga.Show(context.Background(), "14", Include("genres", "mangaStaff.person"))
+	if err != nil {
+		t.Fatalf("Manga.Show returned error: %v", err)
+	}
+
+	want := &Manga{
+		ID: "14",
+		Media: Media{
+			Slug:           "monster",
+			CanonicalTitle: "Monster",
+			Genres:         []*Genre{{ID: "7", Name: "Mystery", Slug: "mystery"}},
+		},
+		ChapterCount:  162,
+		VolumeCount:   18,
+		Serialization: "Big Comic Original",
+		MangaType:     MangaTypeManga,
+		Subtype:       MangaTypeManga,
+		Status:        MangaStatusFinished,
+		Staff: []*MangaStaff{
+			{ID: "3", Role: "Story & Art", Person: &Person{ID: "9", Name: "Naoki Urasawa"}},
+		},
+	}
+	deepEqual(t, got, want, "Manga.Show manga mismatch")
+}
+
+func TestMangaService_Show_notFound(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/"+defaultAPIVersion+"manga/0", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		http.Error(w, `{"errors":[{"title":"Record not found","detail":"The record identified by 0 could not be found.","code":"404","status":"404"}]}`, http.StatusNotFound)
+	})
+
+	_, resp, err := client.Manga.Show(context.Background(), "0")
+	if err == nil {
+		t.Error("Expected HTTP 404 error.")
+	}
+
+	if resp == nil {
+		t.Error("Expected to return HTTP response despite the API error.")
+	}
+}
+
+func TestMangaService_List(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/"+defaultAPIVersion+"manga", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", defaultMediaType)
+		testFormValues(t, r, values{
+			"page[limit]":  "2",
+			"page[offset]": "0",
+			"filter[text]": "monster",
+		})
+
+		fmt.Fprint(w, `{
+			"data":[
+				{"id":"14","type":"manga","attributes":{"slug":"monster","mangaType":"manga"}},
+				{"id":"15","type":"manga","attributes":{"slug":"another-monster","mangaType":"novel"}}
+			],
+			"links":{
+				"first":"https://kitsu.io/api/edge/manga?page%5Blimit%5D=2&page%5Boffset%5D=0",
+				"next":"https://kitsu.io/api/edge/manga?page%5Blimit%5D=2&page%5Boffset%5D=2",
+				"last":"https://kitsu.io/api/edge/manga?page%5Blimit%5D=2&page%5Boffset%5D=8"
+			}
+		}`)
+	})
+
+	got, resp, err := client.Manga.List(context.Background(), Pagination(2, 0), Search("monster"))
+	if err != nil {
+		t.Fatalf("Manga.List returned error: %v", err)
+	}
+
+	want := []*Manga{
+		{ID: "14", Media: Media{Slug: "monster"}, MangaType: MangaTypeManga},
+		{ID: "15", Media: Media{Slug: "another-monster"}, MangaType: MangaTypeNovel},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Manga.List mismatch\nhave: %#+v\nwant: %#+v", got, want)
+	}
+	offset := PageOffset{First: 0, Last: 8, Next: 2, Prev: 0}
+	if got, want := resp.Offset, offset; got != want {
+		t.Errorf("Manga.List response Offset = %+v, want %+v", got, want)
+	}
+}
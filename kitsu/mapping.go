@@ -1,5 +1,7 @@
 package kitsu
 
+import "time"
+
 const (
 	ExternalSiteAniDB      = "anidb"
 	ExternalSiteMALAnime   = "myanimelist/anime"
@@ -13,11 +15,11 @@ type Mapping struct {
 
 	// --- Attributes ---
 
-	// ISO 8601 date and time, e.g. 2017-07-27T22:21:26.824Z
-	CreatedAt string `jsonapi:"attr,createdAt,omitempty"`
+	// Date and time of creation, e.g. 2017-07-27T22:21:26.824Z
+	CreatedAt time.Time `jsonapi:"attr,createdAt,omitempty"`
 
-	// ISO 8601 of last modification, e.g. 2017-07-27T22:47:45.129Z
-	UpdatedAt string `jsonapi:"attr,updatedAt,omitempty"`
+	// Date and time of last modification, e.g. 2017-07-27T22:47:45.129Z
+	UpdatedAt time.Time `jsonapi:"attr,updatedAt,omitempty"`
 
 	ExternalSite string `jsonapi:"attr,externalSite,omitempty"`
 
@@ -0,0 +1,145 @@
+package kitsu
+
+import "time"
+
+// Media holds the attributes and relationships that are common to all media
+// types. It is embedded in Anime, Manga and Drama so its fields can be
+// accessed directly, e.g. a.CanonicalTitle, while code that works with any
+// media type can use the MediaResource interface:
+//
+//	func title(m kitsu.MediaResource) string {
+//		return m.GetMedia().CanonicalTitle
+//	}
+type Media struct {
+	// --- Attributes ---
+
+	// Date and time of creation, e.g. 2017-07-27T22:21:26.824Z
+	CreatedAt time.Time `jsonapi:"attr,createdAt,omitempty"`
+
+	// Date and time of last modification, e.g. 2017-07-27T22:47:45.129Z
+	UpdatedAt time.Time `jsonapi:"attr,updatedAt,omitempty"`
+
+	// Unique slug used for page URLs, e.g. cowboy-bebop
+	Slug string `jsonapi:"attr,slug,omitempty"`
+
+	// Synopsis of the media, e.g.
+	//
+	// In the year 2071, humanity has colonoized several of the planets and
+	// moons...
+	Synopsis string `jsonapi:"attr,synopsis,omitempty"`
+
+	// e.g. 400
+	CoverImageTopOffset int `jsonapi:"attr,coverImageTopOffset,omitempty"`
+
+	// Titles in different languages. Other languages will be listed if they
+	// exist, e.g.
+	//
+	// "en": "Attack on Titan"
+	//
+	// "en_jp": "Shingeki no Kyojin"
+	//
+	// "ja_jp": "進撃の巨人"
+	Titles map[string]string `jsonapi:"attr,titles,omitempty"`
+
+	// Canonical title for the media, e.g. Attack on Titan
+	CanonicalTitle string `jsonapi:"attr,canonicalTitle,omitempty"`
+
+	// Shortened nicknames for the media, e.g. COWBOY BEBOP
+	AbbreviatedTitles []string `jsonapi:"attr,abbreviatedTitles,omitempty"`
+
+	// The average of all user ratings for the media, e.g. 88.65
+	AverageRating string `jsonapi:"attr,averageRating,omitempty"`
+
+	// How many times each rating has been given to the media, e.g.
+	//
+	// "2": "72"
+	//
+	// "3": "0"
+	//
+	// ...
+	//
+	// "19": "40"
+	//
+	// "20": "13607"
+	RatingFrequencies map[string]string `jsonapi:"attr,ratingFrequencies,omitempty"`
+
+	// e.g. 40405
+	UserCount int `jsonapi:"attr,userCount,omitempty"`
+
+	// e.g. 3277
+	FavoritesCount int `jsonapi:"attr,favoritesCount,omitempty"`
+
+	// Date the media started airing or being published, e.g. 2013-04-07
+	StartDate Date `jsonapi:"attr,startDate,omitempty"`
+
+	// Date the media finished airing or being published, e.g. 2013-09-28
+	EndDate Date `jsonapi:"attr,endDate,omitempty"`
+
+	// e.g. 10
+	PopularityRank int `jsonapi:"attr,popularityRank,omitempty"`
+
+	// e.g. 10
+	RatingRank int `jsonapi:"attr,ratingRank,omitempty"`
+
+	// Possible values described by the AgeRating constants.
+	AgeRating AgeRating `jsonapi:"attr,ageRating,omitempty"`
+
+	// Description of the age rating, e.g. 17+ (violence & profanity)
+	AgeRatingGuide string `jsonapi:"attr,ageRatingGuide,omitempty"`
+
+	// The URL template for the poster, e.g.
+	//
+	// "tiny": "https://media.kitsu.io/anime/poster_images/1/tiny.jpg?1431697256"
+	//
+	// "small": "https://media.kitsu.io/anime/poster_images/1/small.jpg?1431697256"
+	//
+	// "medium": "https://media.kitsu.io/anime/poster_images/1/medium.jpg?1431697256"
+	//
+	// "large": "https://media.kitsu.io/anime/poster_images/1/large.jpg?1431697256"
+	//
+	// "original: "https://media.kitsu.io/anime/poster_images/1/original.jpg?1431697256"
+	PosterImage *Image `jsonapi:"attr,posterImage,omitempty"`
+
+	// The URL template for the cover, e.g.
+	//
+	// "tiny": "https://media.kitsu.io/anime/cover_images/1/tiny.jpg?1416336000"
+	//
+	// "small": "https://media.kitsu.io/anime/cover_images/1/small.jpg?1416336000"
+	//
+	// "large": "https://media.kitsu.io/anime/cover_images/1/large.jpg?1416336000"
+	//
+	// "original": "https://media.kitsu.io/anime/cover_images/1/original.jpg?1416336000"
+	CoverImage *Image `jsonapi:"attr,coverImage,omitempty"`
+
+	// --- Relationships ---
+
+	Genres   []*Genre   `jsonapi:"relation,genres,omitempty"`
+	Mappings []*Mapping `jsonapi:"relation,mappings,omitempty"`
+}
+
+// GetMedia returns m. Through embedding, it returns the attributes and
+// relationships common to all media types of an Anime, Manga or Drama.
+func (m *Media) GetMedia() *Media { return m }
+
+// MediaResource is a media that a LibraryEntry can refer to. Its concrete type
+// is one of *Anime, *Manga or *Drama depending on the type of the related
+// resource. The attributes common to all media types can be accessed with
+// GetMedia while the rest can be retrieved with a type switch:
+//
+//	fmt.Println(e.Media.GetMedia().CanonicalTitle)
+//	switch m := e.Media.(type) {
+//	case *kitsu.Anime:
+//		fmt.Println("anime", m.EpisodeCount)
+//	case *kitsu.Manga:
+//		fmt.Println("manga", m.ChapterCount)
+//	case *kitsu.Drama:
+//		fmt.Println("drama", m.EpisodeCount)
+//	}
+type MediaResource interface {
+	GetMedia() *Media
+	mediaResource()
+}
+
+func (*Anime) mediaResource() {}
+func (*Manga) mediaResource() {}
+func (*Drama) mediaResource() {}
@@ -0,0 +1,24 @@
+package kitsu
+
+import "testing"
+
+func TestMediaResource_GetMedia(t *testing.T) {
+	media := []MediaResource{
+		&Anime{ID: "1", Media: Media{CanonicalTitle: "Cowboy Bebop"}},
+		&Manga{ID: "14", Media: Media{CanonicalTitle: "Monster"}},
+		&Drama{ID: "1", Media: Media{CanonicalTitle: "Boys Over Flowers"}},
+	}
+	want := []string{"Cowboy Bebop", "Monster", "Boys Over Flowers"}
+	for i, m := range media {
+		if got := m.GetMedia().CanonicalTitle; got != want[i] {
+			t.Errorf("%T.GetMedia().CanonicalTitle = %q, want %q", m, got, want[i])
+		}
+	}
+
+	// GetMedia gives access to the embedded Media, not a copy.
+	a := new(Anime)
+	a.GetMedia().Slug = "cowboy-bebop"
+	if got, want := a.Slug, "cowboy-bebop"; got != want {
+		t.Errorf("Anime.Slug = %q after setting it through GetMedia, want %q", got, want)
+	}
+}
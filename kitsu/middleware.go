@@ -0,0 +1,43 @@
+package kitsu
+
+import (
+	"context"
+	"net/http"
+)
+
+// Handler sends an API request and decodes the response body into v, as
+// Client.Do does.
+type Handler func(ctx context.Context, req *http.Request, v interface{}) (*Response, error)
+
+// Middleware wraps a Handler to run code before and after an API request.
+// A Middleware can modify the request, e.g. to set a header, before passing
+// it to next, and inspect the decoded Response and error returned by next,
+// e.g. to log or measure the latency of the request:
+//
+//	func timing(next kitsu.Handler) kitsu.Handler {
+//		return func(ctx context.Context, req *http.Request, v interface{}) (*kitsu.Response, error) {
+//			start := time.Now()
+//			resp, err := next(ctx, req, v)
+//			log.Printf("%s %s took %v", req.Method, req.URL, time.Since(start))
+//			return resp, err
+//		}
+//	}
+//
+// A Middleware may also return without calling next, in which case the
+// request is not sent.
+type Middleware func(next Handler) Handler
+
+// Use appends mw to the middleware of the client. See Client.Middleware for
+// the order in which they run.
+func (c *Client) Use(mw ...Middleware) {
+	c.Middleware = append(c.Middleware, mw...)
+}
+
+// handler returns the Handler of Do wrapped by the middleware of the client.
+func (c *Client) handler() Handler {
+	h := c.do
+	for i := len(c.Middleware) - 1; i >= 0; i-- {
+		h = c.Middleware[i](h)
+	}
+	return h
+}
@@ -0,0 +1,121 @@
+package kitsu
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestClient_Use_order(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var calls []string
+	trace := func(name string) Middleware {
+		return func(next Handler) Handler {
+			return func(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
+				calls = append(calls, name+" before")
+				req.Header.Add("X-Trace", name)
+				resp, err := next(ctx, req, v)
+				calls = append(calls, name+" after")
+				return resp, err
+			}
+		}
+	}
+	client.Use(trace("first"), trace("second"))
+	client.Use(trace("third"))
+
+	mux.HandleFunc("/"+defaultAPIVersion+"anime/1", func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, "server")
+		if got, want := r.Header.Values("X-Trace"), []string{"first", "second", "third"}; !reflect.DeepEqual(got, want) {
+			t.Errorf("X-Trace header = %q, want %q", got, want)
+		}
+		fmt.Fprint(w, `{"data":{"id":"1","type":"anime"}}`)
+	})
+
+	if _, _, err := client.Anime.Show(context.Background(), "1"); err != nil {
+		t.Fatalf("Anime.Show returned err: %v", err)
+	}
+
+	want := []string{
+		"first before",
+		"second before",
+		"third before",
+		"server",
+		"third after",
+		"second after",
+		"first after",
+	}
+	if !reflect.DeepEqual(calls, want) {
+		t.Errorf("calls\nhave: %q\nwant: %q", calls, want)
+	}
+}
+
+func TestClient_Use_response(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/"+defaultAPIVersion+"anime", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data":[{"id":"1","type":"anime"}],"meta":{"count":42}}`)
+	})
+	mux.HandleFunc("/"+defaultAPIVersion+"anime/2", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"errors":[{"status":"404","title":"Record not found"}]}`, http.StatusNotFound)
+	})
+
+	var (
+		count  int
+		status int
+		gotErr error
+	)
+	client.Use(func(next Handler) Handler {
+		return func(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
+			resp, err := next(ctx, req, v)
+			count, status, gotErr = 0, 0, err
+			if resp != nil {
+				count, status = resp.Meta.Count, resp.StatusCode
+			}
+			return resp, err
+		}
+	})
+
+	if _, _, err := client.Anime.List(context.Background()); err != nil {
+		t.Fatalf("Anime.List returned err: %v", err)
+	}
+	if got, want := count, 42; got != want {
+		t.Errorf("middleware got meta count %d, want %d", got, want)
+	}
+
+	_, _, err := client.Anime.Show(context.Background(), "2")
+	if !errors.Is(err, ErrNotFound) {
+		t.Fatalf("Anime.Show returned err %v, want ErrNotFound", err)
+	}
+	if got, want := status, http.StatusNotFound; got != want {
+		t.Errorf("middleware got status %d, want %d", got, want)
+	}
+	if gotErr != err {
+		t.Errorf("middleware got err %v, want %v", gotErr, err)
+	}
+}
+
+func TestClient_Use_shortCircuit(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/"+defaultAPIVersion+"anime/1", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request was sent")
+	})
+
+	errBlocked := errors.New("blocked")
+	client.Use(func(next Handler) Handler {
+		return func(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
+			return nil, errBlocked
+		}
+	})
+
+	if _, _, err := client.Anime.Show(context.Background(), "1"); !errors.Is(err, errBlocked) {
+		t.Errorf("Anime.Show returned err %v, want %v", err, errBlocked)
+	}
+}
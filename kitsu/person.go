@@ -0,0 +1,90 @@
+package kitsu
+
+import (
+	"context"
+	"fmt"
+	"iter"
+)
+
+// PersonService handles communication with the people related methods of the
+// Kitsu API.
+//
+// Kitsu API docs:
+// http://docs.kitsu.apiary.io/#reference/characters-&-people/people
+type PersonService service
+
+// Person represents a person that is involved with a certain media. It can be
+// voice actors, animators, etc. Person is a relationship of Casting,
+// AnimeStaff and MangaStaff.
+//
+// Additional filters: name
+type Person struct {
+	ID          string `jsonapi:"primary,people"`
+	Name        string `jsonapi:"attr,name"`
+	MALID       string `jsonapi:"attr,malId"`
+	Description string `jsonapi:"attr,description"`
+	Image       *Image `jsonapi:"attr,image"`
+
+	// --- Relationships ---
+
+	// The staff roles of the person in anime and manga. Include
+	// "animeStaff.anime" or "mangaStaff.manga" to retrieve the media as
+	// well.
+	AnimeStaff []*AnimeStaff `jsonapi:"relation,animeStaff,omitempty"`
+	MangaStaff []*MangaStaff `jsonapi:"relation,mangaStaff,omitempty"`
+
+	// The castings of the person, like the characters they voiced. Include
+	// "castings.character" to retrieve the characters as well.
+	Castings []*Casting `jsonapi:"relation,castings,omitempty"`
+}
+
+// Show returns details for a specific Person by providing a unique identifier
+// of the person e.g. 1.
+func (s *PersonService) Show(ctx context.Context, personID string, opts ...URLOption) (*Person, *Response, error) {
+	u := fmt.Sprintf(defaultAPIVersion+"people/%s", personID)
+
+	req, err := s.client.NewRequest("GET", u, nil, opts...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	p := new(Person)
+	resp, err := s.client.Do(ctx, req, p)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return p, resp, nil
+}
+
+// List returns a list of Person. Optional parameters can be specified to
+// filter the search results and control pagination, sorting etc.
+func (s *PersonService) List(ctx context.Context, opts ...URLOption) ([]*Person, *Response, error) {
+	u := defaultAPIVersion + "people"
+
+	req, err := s.client.NewRequest("GET", u, nil, opts...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var people []*Person
+	resp, err := s.client.Do(ctx, req, &people)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return people, resp, nil
+}
+
+// Search returns a list of Person whose name matches name, e.g. Kouichi
+// Yamadera. It is like List with the name filter as people do not support
+// the Search option.
+func (s *PersonService) Search(ctx context.Context, name string, opts ...URLOption) ([]*Person, *Response, error) {
+	return s.List(ctx, append(opts[:len(opts):len(opts)], Filter("name", name))...)
+}
+
+// All returns an iterator over all the Person that match opts; see
+// AnimeService.All.
+func (s *PersonService) All(ctx context.Context, opts ...URLOption) iter.Seq2[*Person, error] {
+	return all(ctx, s.List, opts)
+}
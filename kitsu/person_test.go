@@ -0,0 +1,159 @@
+package kitsu
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestPersonService_Show(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/"+defaultAPIVersion+"people/47", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", defaultMediaType)
+		testFormValues(t, r, values{
+			"include": "animeStaff.anime,castings.character",
+		})
+		fmt.Fprint(w, `{
+			"data":{
+				"id":"47",
+				"type":"people",
+				"attributes":{"name":"Kouichi Yamadera","malId":"11"},
+				"relationships":{
+					"animeStaff":{"data":[{"type":"animeStaff","id":"5"}]},
+					"castings":{"data":[{"type":"castings","id":"8"}]}
+				}
+			},
+			"included":[
+				{
+					"id":"5",
+					"type":"animeStaff",
+					"attributes":{"role":"ADR Director"},
+					"relationships":{"anime":{"data":{"type":"anime","id":"1"}}}
+				},
+				{"id":"1","type":"anime","attributes":{"canonicalTitle":"Cowboy Bebop"}},
+				{
+					"id":"8",
+					"type":"castings",
+					"attributes":{"role":"Voice Actor","voiceActor":true,"language":"Japanese"},
+					"relationships":{"character":{"data":{"type":"characters","id":"2"}}}
+				},
+				{"id":"2","type":"characters","attributes":{"name":"Spike Spiegel"}}
+			]
+		}`)
+	})
+
+	got, _, err := client.Person.Show(context.Background(), "47", Include("animeStaff.anime", "castings.character"))
+	if err != nil {
+		t.Fatalf("Person.Show returned error: %v", err)
+	}
+
+	want := &Person{
+		ID:    "47",
+		Name:  "Kouichi Yamadera",
+		MALID: "11",
+		AnimeStaff: []*AnimeStaff{
+			{ID: "5", Role: "ADR Director", Anime: &Anime{ID: "1", Media: Media{CanonicalTitle: "Cowboy Bebop"}}},
+		},
+		Castings: []*Casting{
+			{
+				ID:         "8",
+				Role:       "Voice Actor",
+				VoiceActor: true,
+				Language:   "Japanese",
+				Character:  &Character{ID: "2", Name: "Spike Spiegel"},
+			},
+		},
+	}
+	deepEqual(t, got, want, "Person.Show person mismatch")
+}
+
+func TestPersonService_Show_notFound(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/"+defaultAPIVersion+"people/0", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		http.Error(w, `{"errors":[{"title":"Record not found","detail":"The record identified by 0 could not be found.","code":"404","status":"404"}]}`, http.StatusNotFound)
+	})
+
+	_, resp, err := client.Person.Show(context.Background(), "0")
+	if err == nil {
+		t.Error("Expected HTTP 404 error.")
+	}
+
+	if resp == nil {
+		t.Error("Expected to return HTTP response despite the API error.")
+	}
+}
+
+func TestPersonService_List(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/"+defaultAPIVersion+"people", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", defaultMediaType)
+		testFormValues(t, r, values{
+			"page[limit]":  "2",
+			"page[offset]": "2",
+		})
+
+		fmt.Fprint(w, `{
+			"data":[
+				{"id":"47","type":"people","attributes":{"name":"Kouichi Yamadera"}},
+				{"id":"48","type":"people","attributes":{"name":"Megumi Hayashibara"}}
+			],
+			"links":{
+				"first":"https://kitsu.io/api/edge/people?page%5Blimit%5D=2&page%5Boffset%5D=0",
+				"prev":"https://kitsu.io/api/edge/people?page%5Blimit%5D=2&page%5Boffset%5D=0",
+				"next":"https://kitsu.io/api/edge/people?page%5Blimit%5D=2&page%5Boffset%5D=4",
+				"last":"https://kitsu.io/api/edge/people?page%5Blimit%5D=2&page%5Boffset%5D=50"
+			}
+		}`)
+	})
+
+	got, resp, err := client.Person.List(context.Background(), Pagination(2, 2))
+	if err != nil {
+		t.Fatalf("Person.List returned error: %v", err)
+	}
+
+	want := []*Person{
+		{ID: "47", Name: "Kouichi Yamadera"},
+		{ID: "48", Name: "Megumi Hayashibara"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Person.List mismatch\nhave: %#+v\nwant: %#+v", got, want)
+	}
+	offset := PageOffset{First: 0, Last: 50, Next: 4, Prev: 0}
+	if got, want := resp.Offset, offset; got != want {
+		t.Errorf("Person.List response Offset = %+v, want %+v", got, want)
+	}
+}
+
+func TestPersonService_Search(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/"+defaultAPIVersion+"people", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"filter[name]": "yamadera",
+		})
+		fmt.Fprint(w, `{"data":[{"id":"47","type":"people","attributes":{"name":"Kouichi Yamadera"}}]}`)
+	})
+
+	got, _, err := client.Person.Search(context.Background(), "yamadera")
+	if err != nil {
+		t.Fatalf("Person.Search returned error: %v", err)
+	}
+
+	want := []*Person{{ID: "47", Name: "Kouichi Yamadera"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Person.Search mismatch\nhave: %#+v\nwant: %#+v", got, want)
+	}
+}
@@ -0,0 +1,84 @@
+package kitsu
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// RateLimiter limits the rate at which the Client sends requests. Wait blocks
+// until the next request is allowed to be sent or ctx is done.
+//
+// A *rate.Limiter from golang.org/x/time/rate satisfies this interface and
+// can be used instead of TokenBucket.
+type RateLimiter interface {
+	Wait(ctx context.Context) error
+}
+
+// TokenBucket is a RateLimiter that implements the token bucket algorithm. The
+// bucket starts full, holds up to burst tokens and is refilled with rate
+// tokens per second. Each request consumes one token.
+type TokenBucket struct {
+	rate  float64
+	burst float64
+
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+// NewTokenBucket returns a TokenBucket that allows rate requests per second
+// on average, with bursts of at most burst requests.
+func NewTokenBucket(rate float64, burst int) *TokenBucket {
+	if burst < 1 {
+		burst = 1
+	}
+	return &TokenBucket{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// Wait blocks until a token is available or ctx is done. In the latter case
+// the token is given back and ctx.Err() is returned.
+func (b *TokenBucket) Wait(ctx context.Context) error {
+	d := b.reserve(time.Now())
+	if d <= 0 {
+		return nil
+	}
+	if err := sleep(ctx, d); err != nil {
+		b.mu.Lock()
+		b.tokens++
+		b.mu.Unlock()
+		return err
+	}
+	return nil
+}
+
+// reserve takes a token from the bucket and returns how long the caller has
+// to wait before that token becomes available. The number of tokens can go
+// negative which keeps later callers waiting in line.
+func (b *TokenBucket) reserve(now time.Time) time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if elapsed := now.Sub(b.last); elapsed > 0 {
+		b.tokens += elapsed.Seconds() * b.rate
+		if b.tokens > b.burst {
+			b.tokens = b.burst
+		}
+		b.last = now
+	}
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+	if b.rate <= 0 {
+		// No refill: wait forever, or in practice until ctx is done.
+		return time.Duration(math.MaxInt64)
+	}
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
@@ -0,0 +1,81 @@
+package kitsu
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestTokenBucket_reserve(t *testing.T) {
+	b := NewTokenBucket(10, 2)
+	now := b.last
+
+	// The bucket starts full.
+	for i := 0; i < 2; i++ {
+		if d := b.reserve(now); d != 0 {
+			t.Fatalf("reserve #%d = %v, want 0", i+1, d)
+		}
+	}
+	if got, want := b.reserve(now), 100*time.Millisecond; got != want {
+		t.Errorf("reserve on empty bucket = %v, want %v", got, want)
+	}
+	if got, want := b.reserve(now), 200*time.Millisecond; got != want {
+		t.Errorf("second reserve on empty bucket = %v, want %v", got, want)
+	}
+
+	// After a second the bucket is refilled but never above the burst.
+	now = now.Add(time.Second)
+	for i := 0; i < 2; i++ {
+		if d := b.reserve(now); d != 0 {
+			t.Fatalf("reserve #%d after refill = %v, want 0", i+1, d)
+		}
+	}
+}
+
+func TestTokenBucket_Wait_canceledContext(t *testing.T) {
+	b := NewTokenBucket(0.001, 1)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := b.Wait(ctx); err != nil {
+		t.Fatalf("first Wait returned err: %v", err)
+	}
+	if err := b.Wait(ctx); err != context.Canceled {
+		t.Errorf("Wait on empty bucket returned err %v, want %v", err, context.Canceled)
+	}
+}
+
+type countingLimiter struct{ n int }
+
+func (l *countingLimiter) Wait(ctx context.Context) error {
+	l.n++
+	return nil
+}
+
+func TestClient_Do_rateLimiter(t *testing.T) {
+	setup()
+	defer teardown()
+	limiter := new(countingLimiter)
+	client.RateLimiter = limiter
+	client.RetryPolicy = testRetryPolicy
+
+	attempts := 0
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		fmt.Fprint(w, `{}`)
+	})
+
+	req, _ := client.NewRequest("GET", "/", nil)
+	if _, err := client.Do(context.Background(), req, nil); err != nil {
+		t.Fatalf("Do returned err: %v", err)
+	}
+	if limiter.n != 2 {
+		t.Errorf("RateLimiter.Wait called %d times, want 2", limiter.n)
+	}
+}
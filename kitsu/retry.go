@@ -0,0 +1,140 @@
+package kitsu
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultMinBackoff = 500 * time.Millisecond
+	defaultMaxBackoff = 30 * time.Second
+)
+
+// RetryPolicy controls how the Client retries requests that failed because of
+// rate limiting (429 Too Many Requests), a transient server error (502, 503 and
+// 504) or a network error.
+//
+// Retries respect idempotency. Requests with methods that are not idempotent,
+// like the POST of LibraryService.Create, are only retried after a 429
+// response since in that case the API has rejected the request without
+// processing it.
+//
+// The delay between attempts grows exponentially starting from MinBackoff up
+// to MaxBackoff and a random jitter is applied to it. If the response contains
+// a Retry-After header, it is honored instead.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts, including the first one.
+	// A value of 1 or less disables retries.
+	MaxAttempts int
+
+	// MinBackoff is the delay before the first retry. Defaults to 500ms.
+	MinBackoff time.Duration
+
+	// MaxBackoff is the maximum delay between attempts. Defaults to 30s.
+	MaxBackoff time.Duration
+}
+
+// retry reports whether the request should be attempted again after the
+// given attempt returned resp and err, and how long to wait before doing so.
+// It is safe to call on a nil RetryPolicy, which never retries.
+func (p *RetryPolicy) retry(req *http.Request, resp *http.Response, err error, attempt int) (time.Duration, bool) {
+	if p == nil || attempt >= p.MaxAttempts {
+		return 0, false
+	}
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		// The body cannot be replayed.
+		return 0, false
+	}
+	if err != nil {
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return 0, false
+		}
+		if !isIdempotent(req.Method) {
+			return 0, false
+		}
+		return p.backoff(attempt), true
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests:
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		if !isIdempotent(req.Method) {
+			return 0, false
+		}
+	default:
+		return 0, false
+	}
+	if d, ok := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()); ok {
+		return d, true
+	}
+	return p.backoff(attempt), true
+}
+
+// backoff returns the delay to wait after the given attempt. It doubles the
+// delay for every attempt and picks a random value between half of it and
+// the full delay.
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	minBackoff, maxBackoff := p.MinBackoff, p.MaxBackoff
+	if minBackoff <= 0 {
+		minBackoff = defaultMinBackoff
+	}
+	if maxBackoff <= 0 {
+		maxBackoff = defaultMaxBackoff
+	}
+	d := maxBackoff
+	if shift := attempt - 1; shift < 32 && minBackoff<<shift < maxBackoff {
+		d = minBackoff << shift
+	}
+	half := d / 2
+	return half + rand.N(half+1)
+}
+
+// isIdempotent reports whether requests with the given method can safely be
+// sent more than once.
+func isIdempotent(method string) bool {
+	switch method {
+	case "GET", "HEAD", "OPTIONS", "PUT", "DELETE":
+		return true
+	}
+	return false
+}
+
+// parseRetryAfter parses the value of a Retry-After header which can be
+// either a number of seconds or an HTTP date.
+func parseRetryAfter(v string, now time.Time) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(v); err == nil {
+		if secs < 0 {
+			return 0, false
+		}
+		return time.Duration(secs) * time.Second, true
+	}
+	t, err := http.ParseTime(v)
+	if err != nil {
+		return 0, false
+	}
+	if d := t.Sub(now); d > 0 {
+		return d, true
+	}
+	return 0, true
+}
+
+// sleep pauses for d or until ctx is done, whichever happens first.
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
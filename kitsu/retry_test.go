@@ -0,0 +1,195 @@
+package kitsu
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// testRetryPolicy retries fast enough for tests.
+var testRetryPolicy = &RetryPolicy{
+	MaxAttempts: 3,
+	MinBackoff:  time.Millisecond,
+	MaxBackoff:  5 * time.Millisecond,
+}
+
+func TestClient_Do_retryTransientError(t *testing.T) {
+	setup()
+	defer teardown()
+	client.RetryPolicy = testRetryPolicy
+
+	attempts := 0
+	mux.HandleFunc("/"+defaultAPIVersion+"anime/1", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts < 3 {
+			http.Error(w, `{"errors":[{"status":"503","title":"Service Unavailable"}]}`, http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, `{"data":{"id":"1","type":"anime"}}`)
+	})
+
+	got, _, err := client.Anime.Show(context.Background(), "1")
+	if err != nil {
+		t.Fatalf("Anime.Show returned error: %v", err)
+	}
+	if got.ID != "1" {
+		t.Errorf("Anime.Show ID = %q, want %q", got.ID, "1")
+	}
+	if attempts != 3 {
+		t.Errorf("server received %d attempts, want 3", attempts)
+	}
+}
+
+func TestClient_Do_retryMaxAttempts(t *testing.T) {
+	setup()
+	defer teardown()
+	client.RetryPolicy = testRetryPolicy
+
+	attempts := 0
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		http.Error(w, `{"errors":[{"status":"429","title":"Too Many Requests"}]}`, http.StatusTooManyRequests)
+	})
+
+	req, _ := client.NewRequest("GET", "/", nil)
+	resp, err := client.Do(context.Background(), req, nil)
+	if _, ok := err.(*ErrorResponse); !ok {
+		t.Fatalf("Do returned err %v, want *ErrorResponse", err)
+	}
+	if got, want := resp.StatusCode, http.StatusTooManyRequests; got != want {
+		t.Errorf("Do response code = %d, want %d", got, want)
+	}
+	if attempts != testRetryPolicy.MaxAttempts {
+		t.Errorf("server received %d attempts, want %d", attempts, testRetryPolicy.MaxAttempts)
+	}
+}
+
+func TestClient_Do_retryNonIdempotent(t *testing.T) {
+	setup()
+	defer teardown()
+	client.RetryPolicy = testRetryPolicy
+
+	var bodies []string
+	status := http.StatusServiceUnavailable
+	mux.HandleFunc("/"+defaultAPIVersion+"library-entries", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		b, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(b))
+		if len(bodies) == 1 {
+			w.WriteHeader(status)
+			return
+		}
+		fmt.Fprint(w, `{"data":{"id":"1","type":"libraryEntries"}}`)
+	})
+
+	// A POST must not be replayed after a server error.
+	_, _, err := client.Library.Create(context.Background(), &LibraryEntry{Status: LibraryEntryStatusCurrent})
+	if err == nil {
+		t.Fatal("Library.Create expected to return err")
+	}
+	if len(bodies) != 1 {
+		t.Fatalf("server received %d attempts for POST after 503, want 1", len(bodies))
+	}
+
+	// It can be replayed after 429 since the request was not processed.
+	bodies = nil
+	status = http.StatusTooManyRequests
+	_, _, err = client.Library.Create(context.Background(), &LibraryEntry{Status: LibraryEntryStatusCurrent})
+	if err != nil {
+		t.Fatalf("Library.Create returned error: %v", err)
+	}
+	if len(bodies) != 2 {
+		t.Fatalf("server received %d attempts for POST after 429, want 2", len(bodies))
+	}
+	if bodies[0] == "" || bodies[0] != bodies[1] {
+		t.Errorf("retried request body = %q, want %q", bodies[1], bodies[0])
+	}
+}
+
+func TestClient_Do_retryCanceledContext(t *testing.T) {
+	setup()
+	defer teardown()
+	client.RetryPolicy = &RetryPolicy{MaxAttempts: 2, MinBackoff: time.Hour}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		cancel()
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	req, _ := client.NewRequest("GET", "/", nil)
+	_, err := client.Do(ctx, req, nil)
+	if err != context.Canceled {
+		t.Errorf("Do returned err %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestRetryPolicy_retryAfter(t *testing.T) {
+	p := &RetryPolicy{MaxAttempts: 2}
+	req, _ := http.NewRequest("GET", "/", nil)
+	resp := &http.Response{
+		StatusCode: http.StatusTooManyRequests,
+		Header:     http.Header{"Retry-After": []string{"7"}},
+	}
+
+	wait, retry := p.retry(req, resp, nil, 1)
+	if !retry {
+		t.Fatal("retry after 429 = false, want true")
+	}
+	if want := 7 * time.Second; wait != want {
+		t.Errorf("retry wait = %v, want %v", wait, want)
+	}
+}
+
+func TestRetryPolicy_nil(t *testing.T) {
+	var p *RetryPolicy
+	req, _ := http.NewRequest("GET", "/", nil)
+	resp := &http.Response{StatusCode: http.StatusServiceUnavailable}
+	if _, retry := p.retry(req, resp, nil, 1); retry {
+		t.Error("nil RetryPolicy retry = true, want false")
+	}
+}
+
+func TestRetryPolicy_backoff(t *testing.T) {
+	p := &RetryPolicy{MinBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+	var tests = []struct {
+		attempt  int
+		min, max time.Duration
+	}{
+		{1, 50 * time.Millisecond, 100 * time.Millisecond},
+		{2, 100 * time.Millisecond, 200 * time.Millisecond},
+		{4, 400 * time.Millisecond, 800 * time.Millisecond},
+		{5, 500 * time.Millisecond, time.Second},
+		{100, 500 * time.Millisecond, time.Second},
+	}
+	for _, tt := range tests {
+		if got := p.backoff(tt.attempt); got < tt.min || got > tt.max {
+			t.Errorf("backoff(%d) = %v, want between %v and %v", tt.attempt, got, tt.min, tt.max)
+		}
+	}
+}
+
+func Test_parseRetryAfter(t *testing.T) {
+	now := time.Date(2017, 7, 27, 22, 21, 26, 0, time.UTC)
+	var tests = []struct {
+		in   string
+		want time.Duration
+		ok   bool
+	}{
+		{"", 0, false},
+		{"120", 2 * time.Minute, true},
+		{"-1", 0, false},
+		{"Thu, 27 Jul 2017 22:21:36 GMT", 10 * time.Second, true},
+		{"Thu, 27 Jul 2017 22:21:16 GMT", 0, true},
+		{"soon", 0, false},
+	}
+	for _, tt := range tests {
+		got, ok := parseRetryAfter(tt.in, now)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("parseRetryAfter(%q) = %v, %v, want %v, %v", tt.in, got, ok, tt.want, tt.ok)
+		}
+	}
+}
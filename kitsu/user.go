@@ -1,20 +1,29 @@
 package kitsu
 
 import (
+	"context"
 	"fmt"
+	"iter"
+	"time"
 )
 
+// UserRatingSystem is the rating system a User has chosen.
+type UserRatingSystem string
+
 // Possible values for User.RatingSystem.
 const (
-	UserRatingSystemAdvanced = "advanced"
-	UserRatingSystemRegular  = "regular"
-	UserRatingSystemSimple   = "simple"
+	UserRatingSystemAdvanced UserRatingSystem = "advanced"
+	UserRatingSystemRegular  UserRatingSystem = "regular"
+	UserRatingSystemSimple   UserRatingSystem = "simple"
 )
 
+// UserTheme is the site theme a User has chosen.
+type UserTheme string
+
 // Possible values for User.Theme.
 const (
-	UserThemeLight = "light"
-	UserThemeDark  = "dark"
+	UserThemeLight UserTheme = "light"
+	UserThemeDark  UserTheme = "dark"
 )
 
 // UserService handles communication with the user related methods of the
@@ -30,11 +39,11 @@ type User struct {
 
 	// --- Attributes ---
 
-	// ISO 8601 date and time, e.g. 2017-07-27T22:21:26.824Z
-	CreatedAt string `jsonapi:"attr,createdAt,omitempty"`
+	// Date and time of creation, e.g. 2017-07-27T22:21:26.824Z
+	CreatedAt time.Time `jsonapi:"attr,createdAt,omitempty"`
 
-	// ISO 8601 of last modification, e.g. 2017-07-27T22:47:45.129Z
-	UpdatedAt string `jsonapi:"attr,updatedAt,omitempty"`
+	// Date and time of last modification, e.g. 2017-07-27T22:47:45.129Z
+	UpdatedAt time.Time `jsonapi:"attr,updatedAt,omitempty"`
 
 	// e.g. vikhyat
 	Name string `jsonapi:"attr,name,omitempty"`
@@ -61,7 +70,8 @@ type User struct {
 	// e.g. 2031
 	FollowingCount int `jsonapi:"attr,followingCount,omitempty"`
 
-	Birthday string `jsonapi:"attr,birthday,omitempty"`
+	// e.g. 1990-05-21
+	Birthday Date   `jsonapi:"attr,birthday,omitempty"`
 	Gender   string `jsonapi:"attr,gender,omitempty"`
 
 	CommentsCount       int `jsonapi:"attr,commentsCount,omitempty"`
@@ -73,8 +83,8 @@ type User struct {
 	RatingsCount        int `jsonapi:"attr,ratingsCount,omitempty"`
 	MediaReactionsCount int `jsonapi:"attr,mediaReactionsCount,omitempty"`
 
-	// e.g. 2015-01-30T16:49:35.173Z
-	ProExpiresAt string `jsonapi:"attr,proExpiresAt,omitempty"`
+	// When the PRO subscription expires, e.g. 2015-01-30T16:49:35.173Z
+	ProExpiresAt time.Time `jsonapi:"attr,proExpiresAt,omitempty"`
 
 	Title string `jsonapi:"attr,title,omitempty"`
 
@@ -95,14 +105,14 @@ type User struct {
 	//
 	// It may also contain a meta object with additional dimensions objects for
 	// each previous Avatar type.
-	Avatar     map[string]interface{} `jsonapi:"attr,avatar,omitempty"`
-	CoverImage map[string]interface{} `jsonapi:"attr,coverImage,omitempty"`
+	Avatar     *Image `jsonapi:"attr,avatar,omitempty"`
+	CoverImage *Image `jsonapi:"attr,coverImage,omitempty"`
 
 	// Possible valued described by UserRatingSystem constants.
-	RatingSystem string `jsonapi:"attr,ratingSystem,omitempty"`
+	RatingSystem UserRatingSystem `jsonapi:"attr,ratingSystem,omitempty"`
 
 	// Possible valued described by UserTheme constants.
-	Theme string `jsonapi:"attr,theme,omitempty"`
+	Theme UserTheme `jsonapi:"attr,theme,omitempty"`
 
 	FacebookID string `jsonapi:"attr,facebookId,omitempty"`
 
@@ -114,7 +124,7 @@ type User struct {
 
 // Show returns details for a specific User by providing the ID of the user
 // e.g. 29745.
-func (s *UserService) Show(userID string, opts ...URLOption) (*User, *Response, error) {
+func (s *UserService) Show(ctx context.Context, userID string, opts ...URLOption) (*User, *Response, error) {
 	u := fmt.Sprintf(defaultAPIVersion+"users/%s", userID)
 
 	req, err := s.client.NewRequest("GET", u, nil, opts...)
@@ -123,7 +133,7 @@ func (s *UserService) Show(userID string, opts ...URLOption) (*User, *Response,
 	}
 
 	user := new(User)
-	resp, err := s.client.Do(req, user)
+	resp, err := s.client.Do(ctx, req, user)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -133,7 +143,7 @@ func (s *UserService) Show(userID string, opts ...URLOption) (*User, *Response,
 
 // List returns a list of Users. Optional parameters can be specified to filter
 // the search results and control pagination, sorting etc.
-func (s *UserService) List(opts ...URLOption) ([]*User, *Response, error) {
+func (s *UserService) List(ctx context.Context, opts ...URLOption) ([]*User, *Response, error) {
 	u := defaultAPIVersion + "users"
 
 	req, err := s.client.NewRequest("GET", u, nil, opts...)
@@ -142,10 +152,27 @@ func (s *UserService) List(opts ...URLOption) ([]*User, *Response, error) {
 	}
 
 	var users []*User
-	resp, err := s.client.Do(req, &users)
+	resp, err := s.client.Do(ctx, req, &users)
 	if err != nil {
 		return nil, resp, err
 	}
 
 	return users, resp, nil
 }
+
+// GetMany returns the Users with the given IDs using as few requests as
+// possible. The IDs are requested in chunks with the id filter and a few chunks
+// are requested concurrently.
+//
+// The Users are returned in the order of ids with a nil in place of each user
+// that was not found. The IDs that were not found are also returned. Optional
+// parameters like Include apply to every chunk.
+func (s *UserService) GetMany(ctx context.Context, ids []string, opts ...URLOption) ([]*User, []string, error) {
+	return getMany(ctx, s.List, func(u *User) string { return u.ID }, ids, opts)
+}
+
+// All returns an iterator over all the Users that match opts; see
+// AnimeService.All.
+func (s *UserService) All(ctx context.Context, opts ...URLOption) iter.Seq2[*User, error] {
+	return all(ctx, s.List, opts)
+}
@@ -1,11 +1,13 @@
 package kitsu
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"reflect"
 	"testing"
+	"time"
 )
 
 func TestUserService_Show(t *testing.T) {
@@ -18,15 +20,21 @@ func TestUserService_Show(t *testing.T) {
 		testFormValues(t, r, values{
 			"filter[name]": "chitanda",
 		})
-		fmt.Fprintf(w, `{"data":{"id":"29745","type":"users","attributes":{"name":"chitanda","pastNames":["foo","bar"]}}}`)
+		fmt.Fprintf(w, `{"data":{"id":"29745","type":"users","attributes":{"name":"chitanda","pastNames":["foo","bar"],"createdAt":"2017-07-27T22:21:26.824Z","birthday":"1990-05-21","proExpiresAt":null}}}`)
 	})
 
-	got, _, err := client.User.Show("29745", Filter("name", "chitanda"))
+	got, _, err := client.User.Show(context.Background(), "29745", Filter("name", "chitanda"))
 	if err != nil {
 		t.Errorf("User.Show returned error: %v", err)
 	}
 
-	want := &User{ID: "29745", Name: "chitanda", PastNames: []string{"foo", "bar"}}
+	want := &User{
+		ID:        "29745",
+		Name:      "chitanda",
+		PastNames: []string{"foo", "bar"},
+		CreatedAt: time.Date(2017, 7, 27, 22, 21, 26, 824e6, time.UTC),
+		Birthday:  Date{1990, time.May, 21},
+	}
 	if !reflect.DeepEqual(got, want) {
 		t.Errorf("User.Show user mismatch\nhave: %#+v\nwant: %#+v", got, want)
 	}
@@ -42,7 +50,7 @@ func TestUserService_Show_notFound(t *testing.T) {
 		http.Error(w, `{"errors":[{"title":"Record not found","detail":"The record identified by 0 could not be found.","code":"404","status":"404"}]}`, http.StatusNotFound)
 	})
 
-	_, resp, err := client.User.Show("0", nil)
+	_, resp, err := client.User.Show(context.Background(), "0", nil)
 	if err == nil {
 		t.Error("Expected HTTP 404 error.")
 	}
@@ -86,6 +94,7 @@ func TestUserService_List(t *testing.T) {
 	})
 
 	got, resp, err := client.User.List(
+		context.Background(),
 		Pagination(2, 0),
 		Filter("name", "vikhyat"),
 		Sort("-followersCount"),
@@ -111,4 +120,7 @@ func TestUserService_List(t *testing.T) {
 	if got, want := resp.Offset, offset; got != want {
 		t.Errorf("User.List response Offset = %+v, want %+v", got, want)
 	}
+	if got, want := resp.Meta, (Meta{}); !reflect.DeepEqual(got, want) {
+		t.Errorf("User.List response Meta = %+v, want %+v", got, want)
+	}
 }